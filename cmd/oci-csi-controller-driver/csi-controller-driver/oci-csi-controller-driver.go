@@ -27,8 +27,9 @@ const (
 	bvCsiDriver = "BV"
 )
 
-// StartControllerDriver main function to start CSI Controller Driver
-func StartControllerDriver(csioptions csioptions.CSIOptions, csiDriver driver.CSIDriver) {
+// StartControllerDriver main function to start CSI Controller Driver, the
+// background loops of the driver run until the stop channel is closed
+func StartControllerDriver(csioptions csioptions.CSIOptions, csiDriver driver.CSIDriver, stopCh <-chan struct{}) {
 
 	logger := logging.Logger().Sugar()
 	logger.Sync()
@@ -43,10 +44,13 @@ func StartControllerDriver(csioptions csioptions.CSIOptions, csiDriver driver.CS
 	}
 
 	if csiDriver == bvCsiDriver {
-		controllerDriverConfig := &driver.ControllerDriverConfig{CsiEndpoint: csioptions.Endpoint, CsiKubeConfig: csioptions.Kubeconfig, CsiMaster: csioptions.Master, EnableControllerServer: true, DriverName: driver.BlockVolumeDriverName, DriverVersion: driver.BlockVolumeDriverVersion, ClusterIpFamily: clusterIpFamily}
+		controllerDriverConfig := &driver.ControllerDriverConfig{CsiEndpoint: csioptions.Endpoint, CsiKubeConfig: csioptions.Kubeconfig, CsiMaster: csioptions.Master, EnableControllerServer: true, DriverName: driver.BlockVolumeDriverName, DriverVersion: driver.BlockVolumeDriverVersion, ClusterIpFamily: clusterIpFamily,
+			ForceDetachReconcilePeriod: csioptions.ForceDetachReconcilePeriod, ForceDetachGracePeriod: csioptions.ForceDetachGracePeriod,
+			EphemeralVolumeCleanupPeriod: csioptions.EphemeralVolumeCleanupPeriod, VolumeReplicaSyncPeriod: csioptions.VolumeReplicaSyncPeriod,
+			StopCh: stopCh}
 		drv, err = driver.NewControllerDriver(logger, *controllerDriverConfig)
 	} else {
-		controllerDriverConfig := &driver.ControllerDriverConfig{CsiEndpoint: csioptions.FssEndpoint, CsiKubeConfig: csioptions.Kubeconfig, CsiMaster: csioptions.Master, EnableControllerServer: true, DriverName: driver.FSSDriverName, DriverVersion: driver.FSSDriverVersion, ClusterIpFamily: clusterIpFamily}
+		controllerDriverConfig := &driver.ControllerDriverConfig{CsiEndpoint: csioptions.FssEndpoint, CsiKubeConfig: csioptions.Kubeconfig, CsiMaster: csioptions.Master, EnableControllerServer: true, DriverName: driver.FSSDriverName, DriverVersion: driver.FSSDriverVersion, ClusterIpFamily: clusterIpFamily, StopCh: stopCh}
 		drv, err = driver.NewControllerDriver(logger, *controllerDriverConfig)
 	}
 	if err != nil {
@@ -55,11 +55,17 @@ type CSIOptions struct {
 	FinalizerThreads        uint
 	MetricsAddress          string
 	MetricsPath             string
+	TracingEndpoint         string
+	TracingSamplingRate     int
 	ExtraCreateMetadata     bool
 	ReconcileSync           time.Duration
 	EnableResizer           bool
 	GroupSnapshotNamePrefix   string
 	GroupSnapshotNameUUIDLength int
+	ForceDetachReconcilePeriod  time.Duration
+	ForceDetachGracePeriod      time.Duration
+	EphemeralVolumeCleanupPeriod time.Duration
+	VolumeReplicaSyncPeriod     time.Duration
 
 }
 
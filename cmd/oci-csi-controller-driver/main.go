@@ -15,18 +15,23 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"net/http"
 	"time"
 
 	csicontrollerdriver "github.com/oracle/oci-cloud-controller-manager/cmd/oci-csi-controller-driver/csi-controller-driver"
 	"github.com/oracle/oci-cloud-controller-manager/cmd/oci-csi-controller-driver/csioptions"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/csi/driver"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/logging"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/signals"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/component-base/metrics/legacyregistry"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 )
 
 func main() {
@@ -35,6 +40,14 @@ func main() {
 	flag.StringVar(&csiOptions.FssEndpoint, "fss-csi-endpoint", "unix://tmp/csi-fss.sock", "CSI FSS endpoint")
 	flag.StringVar(&csiOptions.Master, "master", "", "kube master")
 	flag.StringVar(&csiOptions.Kubeconfig, "kubeconfig", "", "cluster kubeconfig")
+	flag.StringVar(&csiOptions.MetricsAddress, "metrics-address", "", "The TCP network address where the prometheus metrics endpoint will listen (example: `:8080`). The default is empty string, which means metrics endpoint is disabled.")
+	flag.StringVar(&csiOptions.MetricsPath, "metrics-path", "/metrics", "The HTTP path where prometheus metrics will be exposed. Default is `/metrics`.")
+	flag.StringVar(&csiOptions.TracingEndpoint, "tracing-endpoint", "", "OTLP gRPC endpoint of the OpenTelemetry collector traces are exported to (example: `localhost:4317`). The default is empty string, which means tracing is disabled.")
+	flag.IntVar(&csiOptions.TracingSamplingRate, "tracing-sampling-rate-per-million", 0, "Number of traces sampled per million. The sampling decision of the parent span, e.g. of a CSI sidecar, is always respected.")
+	flag.DurationVar(&csiOptions.ForceDetachReconcilePeriod, "force-detach-reconcile-period", 5*time.Minute, "Period of the force detach of the block volumes whose volume attachments are stuck on deleted nodes or terminated instances. 0 disables the force detach.")
+	flag.DurationVar(&csiOptions.EphemeralVolumeCleanupPeriod, "ephemeral-volume-cleanup-period", 0, "Period of the clean up of the block volumes of CSI ephemeral inline volumes left by terminated instances. The default is 0, which means the clean up is disabled.")
+	flag.DurationVar(&csiOptions.VolumeReplicaSyncPeriod, "volume-replica-sync-period", 5*time.Minute, "Period of the updates of the block volume replicas annotations of the persistent volumes of replicated block volumes. 0 disables the updates.")
+	flag.DurationVar(&csiOptions.ForceDetachGracePeriod, "force-detach-grace-period", 10*time.Minute, "How long the volume attachments of deleted nodes or terminated instances must have been deleting before their block volumes are force detached.")
 	flag.Parse()
 	stopCh := signals.SetupSignalHandler()
 	log := logging.Logger()
@@ -59,9 +72,35 @@ func main() {
 	//setting timeout to 200 seconds for BV driver (used for ControllerPublish/ControllerUnpublish/ControllerExpand gRPCs)
 	csiOptions.Timeout = 200 * time.Second
 
+	if csiOptions.MetricsAddress != "" {
+		go serveMetrics(logger, csiOptions.MetricsAddress, csiOptions.MetricsPath)
+	}
+
+	if csiOptions.TracingEndpoint != "" {
+		samplingRate := int32(csiOptions.TracingSamplingRate)
+		shutdown, err := tracing.Setup(context.Background(), "oci-csi-controller-driver", &tracingapi.TracingConfiguration{
+			Endpoint:               &csiOptions.TracingEndpoint,
+			SamplingRatePerMillion: &samplingRate,
+		})
+		if err != nil {
+			logger.With(zap.Error(err)).Fatal("Failed to enable tracing")
+		}
+		defer shutdown(context.Background())
+	}
+
 	logger.With("endpoint", csiOptions.Endpoint).Infof("Starting controller driver go routine.")
-	go csicontrollerdriver.StartControllerDriver(csiOptions, driver.BV)
+	go csicontrollerdriver.StartControllerDriver(csiOptions, driver.BV, stopCh)
 
-	go csicontrollerdriver.StartControllerDriver(csiOptions, driver.FSS)
+	go csicontrollerdriver.StartControllerDriver(csiOptions, driver.FSS, stopCh)
 	<-stopCh
 }
+
+// serveMetrics exposes the OCI API and driver metrics of the legacy registry.
+func serveMetrics(logger *zap.SugaredLogger, address, path string) {
+	mux := http.NewServeMux()
+	mux.Handle(path, legacyregistry.Handler())
+	logger.With("address", address, "path", path).Info("Starting metrics endpoint")
+	if err := http.ListenAndServe(address, mux); err != nil {
+		logger.With(zap.Error(err)).Error("Metrics endpoint failed")
+	}
+}
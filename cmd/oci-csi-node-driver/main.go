@@ -15,17 +15,21 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap/zapcore"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/klog"
 
 	"github.com/oracle/oci-cloud-controller-manager/cmd/oci-csi-node-driver/nodedriver"
 	"github.com/oracle/oci-cloud-controller-manager/cmd/oci-csi-node-driver/nodedriveroptions"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/csi/driver"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/signals"
 )
 
@@ -42,6 +46,13 @@ func main() {
 	flag.StringVar(&nodecsioptions.LustreEndpoint, "lustre-endpoint", "unix:///lustre/csi.sock", "Lustre CSI endpoint")
 	flag.StringVar(&nodecsioptions.LustreCsiAddress, "lustre-csi-address", "/lustre/csi.sock", "Path of the Lustre CSI driver socket that the node-driver-registrar will connect to.")
 	flag.StringVar(&nodecsioptions.LustreKubeletRegistrationPath, "lustre-kubelet-registration-path", "/var/lib/kubelet/plugins/lustre.csi.oraclecloud.com/csi.sock", "Path of the Lustre CSI driver socket on the Kubernetes host machine.")
+	flag.StringVar(&nodecsioptions.TracingEndpoint, "tracing-endpoint", "", "OTLP gRPC endpoint of the OpenTelemetry collector traces are exported to (example: `localhost:4317`). The default is empty string, which means tracing is disabled.")
+	flag.IntVar(&nodecsioptions.TracingSamplingRate, "tracing-sampling-rate-per-million", 0, "Number of traces sampled per million. The sampling decision of the parent span, e.g. of the controller driver, is always respected.")
+	flag.Int64Var(&nodecsioptions.MaxVolumesPerNode, "max-volumes-per-node", 0, "Maximum number of block volumes attached to the node, including the boot volume, when the attachment limit of the shape of the node is unknown. The default is 0, which means 32.")
+	flag.DurationVar(&nodecsioptions.StaleAttachmentReconcilePeriod, "stale-attachment-reconcile-period", 10*time.Minute, "Period of the clean up of the iSCSI sessions, node records, multipath devices and mounts left on the node by block volumes detached from it, which also runs at startup. 0 disables the clean up.")
+	flag.BoolVar(&nodecsioptions.StaleAttachmentReconcileDryRun, "stale-attachment-reconcile-dry-run", false, "Only log the clean up actions of the stale block volume attachments of the node.")
+	flag.BoolVar(&nodecsioptions.AnnotateAttachmentSupport, "annotate-attachment-support", true, "Record whether iscsid and multipathd run on the node and whether it supports paravirtualized attachments in the oci.oraclecloud.com/block-volume-attachment-support annotation of the node at startup, which the controller driver chooses the attachment types of the block volumes with.")
+	flag.StringVar(&nodecsioptions.EphemeralVolumeConfig, "ephemeral-volume-config", "", "Path of the cloud-provider configuration the block volumes of CSI ephemeral inline volumes are created, attached and deleted with, e.g. only `useInstancePrincipals: true`. The default is empty string, which means ephemeral volumes are disabled.")
 
 	klog.InitFlags(nil)
 	flag.Set("logtostderr", "true")
@@ -60,6 +71,12 @@ func main() {
 		DriverName:             driver.BlockVolumeDriverName,
 		DriverVersion:          driver.BlockVolumeDriverVersion,
 		EnableControllerServer: false,
+		MaxVolumesPerNode:      nodecsioptions.MaxVolumesPerNode,
+
+		StaleAttachmentReconcilePeriod: nodecsioptions.StaleAttachmentReconcilePeriod,
+		StaleAttachmentReconcileDryRun: nodecsioptions.StaleAttachmentReconcileDryRun,
+		AnnotateAttachmentSupport:      nodecsioptions.AnnotateAttachmentSupport,
+		EphemeralVolumeConfig:          nodecsioptions.EphemeralVolumeConfig,
 	}
 	fssNodeOptions := nodedriveroptions.NodeOptions{
 		Name:                   "FSS",
@@ -85,6 +102,18 @@ func main() {
 
 	stopCh := signals.SetupSignalHandler()
 
+	if nodecsioptions.TracingEndpoint != "" {
+		samplingRate := int32(nodecsioptions.TracingSamplingRate)
+		shutdown, err := tracing.Setup(context.Background(), "oci-csi-node-driver", &tracingapi.TracingConfiguration{
+			Endpoint:               &nodecsioptions.TracingEndpoint,
+			SamplingRatePerMillion: &samplingRate,
+		})
+		if err != nil {
+			klog.Fatalf("Failed to enable tracing: %v", err)
+		}
+		defer shutdown(context.Background())
+	}
+
 	go nodedriver.RunNodeDriver(blockvolumeNodeOptions, stopCh)
 	if nodecsioptions.EnableFssDriver {
 		go nodedriver.RunNodeDriver(fssNodeOptions, stopCh)
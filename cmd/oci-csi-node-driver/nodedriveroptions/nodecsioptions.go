@@ -14,6 +14,8 @@
 
 package nodedriveroptions
 
+import "time"
+
 //NodeCSIOptions contains details about the flag
 type NodeCSIOptions struct {
 	Endpoint   string // Used for Block Volume CSI driver
@@ -27,6 +29,18 @@ type NodeCSIOptions struct {
 	LustreCsiAddress              string
 	LustreKubeletRegistrationPath string
 	LustreEndpoint                string
+
+	TracingEndpoint     string
+	TracingSamplingRate int
+
+	MaxVolumesPerNode int64
+
+	StaleAttachmentReconcilePeriod time.Duration
+	StaleAttachmentReconcileDryRun bool
+
+	AnnotateAttachmentSupport bool
+
+	EphemeralVolumeConfig string
 }
 
 type NodeOptions struct {
@@ -38,4 +52,18 @@ type NodeOptions struct {
 	DriverName             string
 	DriverVersion          string
 	EnableControllerServer bool
+	// MaxVolumesPerNode is the maximum number of volumes attached to nodes of
+	// shapes of unknown attachment limits, 0 for the default
+	MaxVolumesPerNode int64
+	// StaleAttachmentReconcilePeriod is the period of the clean up of the
+	// attachments of volumes detached from the node, 0 to disable it
+	StaleAttachmentReconcilePeriod time.Duration
+	// StaleAttachmentReconcileDryRun only logs the clean up actions
+	StaleAttachmentReconcileDryRun bool
+	// AnnotateAttachmentSupport records the block volume attachment types
+	// supported by the node in an annotation of the node at startup
+	AnnotateAttachmentSupport bool
+	// EphemeralVolumeConfig is the path of the cloud-provider configuration
+	// of the ephemeral volumes, empty to disable them
+	EphemeralVolumeConfig string
 }
@@ -0,0 +1,139 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// oci-volume-populator runs the controller populating the PVCs whose
+// dataSourceRef is an OCIObjectStoragePopulator with --controller, and
+// otherwise runs in the populator pods of the controller and populates a
+// volume from OCI Object Storage.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"go.uber.org/zap"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/csi/driver"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/csi/populator"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/logging"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/signals"
+)
+
+// configFilePath is the default path of the cloud-provider configuration
+const configFilePath = "/etc/oci/config.yaml"
+
+func main() {
+	var controller bool
+	var master, kubeconfig, populatorImage, populatorNamespace, populatorConfigSecret string
+	flag.BoolVar(&controller, "controller", false, "Run the controller populating the PVCs whose dataSourceRef is an OCIObjectStoragePopulator.")
+	flag.StringVar(&master, "master", "", "kube master, with --controller")
+	flag.StringVar(&kubeconfig, "kubeconfig", "", "cluster kubeconfig, with --controller")
+	flag.StringVar(&populatorImage, "populator-image", "", "Image of the populator pods, with the oci-volume-populator command, with --controller.")
+	flag.StringVar(&populatorNamespace, "populator-namespace", "kube-system", "Namespace of the populator pods and of the PVCs they populate, with --controller.")
+	flag.StringVar(&populatorConfigSecret, "populator-config-secret", "", "Secret of the cloud-provider configuration mounted in the populator pods for the Object Storage credentials, with --controller. The default is empty string, which means the Object Storage requests are not signed.")
+
+	var namespace, bucket, prefix, image, region, endpoint, mountPath, devicePath string
+	flag.StringVar(&namespace, "object-storage-namespace", "", "Object Storage namespace of the bucket.")
+	flag.StringVar(&bucket, "bucket", "", "Name of the bucket.")
+	flag.StringVar(&prefix, "prefix", "", "Prefix of the objects copied into the filesystem mounted at --mount-path.")
+	flag.StringVar(&image, "image", "", "Name of the disk image object written to the device at --device-path.")
+	flag.StringVar(&region, "region", "", "Region of the bucket. Defaults to the region of the cloud-provider configuration.")
+	flag.StringVar(&endpoint, "endpoint", "", "Object Storage endpoint overriding the endpoint of the region.")
+	flag.StringVar(&mountPath, "mount-path", populator.MountPath, "Path the Filesystem volume is mounted at.")
+	flag.StringVar(&devicePath, "device-path", populator.DevicePath, "Path of the device of the Block volume.")
+	flag.Parse()
+
+	if controller {
+		runController(master, kubeconfig, populator.Config{
+			Image:        populatorImage,
+			Namespace:    populatorNamespace,
+			ConfigSecret: populatorConfigSecret,
+			Provisioners: []string{driver.BlockVolumeDriverName, driver.FSSDriverName},
+			ResyncPeriod: 10 * time.Second,
+		})
+		return
+	}
+
+	logger := logging.Logger().Sugar().With("namespace", namespace, "bucket", bucket)
+	defer logger.Sync()
+
+	var signer common.HTTPRequestSigner
+	configPath, ok := os.LookupEnv("CONFIG_YAML_FILENAME")
+	if !ok {
+		configPath = configFilePath
+	}
+	if _, err := os.Stat(configPath); err == nil {
+		cfg, err := providercfg.FromFile(configPath)
+		if err != nil {
+			logger.With(zap.Error(err)).With("config", configPath).Fatal("Failed to load configuration file from given path.")
+		}
+		cp, err := providercfg.NewConfigurationProvider(cfg)
+		if err != nil {
+			logger.With(zap.Error(err)).Fatal("Failed to create the OCI configuration provider.")
+		}
+		if region == "" {
+			if region, err = cp.Region(); err != nil {
+				logger.With(zap.Error(err)).Fatal("Failed to get the region of the OCI configuration.")
+			}
+		}
+		signer = common.DefaultRequestSigner(cp)
+	} else {
+		logger.With("config", configPath).Warn("No configuration file, Object Storage requests are not signed.")
+	}
+
+	c := populator.NewObjectStorageClient(endpoint, region, namespace, bucket, signer)
+	ctx := context.Background()
+	var err error
+	if image != "" {
+		err = populator.PopulateBlock(ctx, logger, c, image, devicePath)
+	} else {
+		err = populator.PopulateFilesystem(ctx, logger, c, prefix, mountPath)
+	}
+	if err != nil {
+		// the termination message of the pod is reported in the events of
+		// the PVC
+		_ = os.WriteFile("/dev/termination-log", []byte(err.Error()), 0644)
+		logger.With(zap.Error(err)).Fatal("Failed to populate the volume.")
+	}
+}
+
+// runController runs the populator controller until the process is signaled.
+func runController(master, kubeconfig string, config populator.Config) {
+	logger := logging.Logger().Sugar()
+	defer logger.Sync()
+	if config.Image == "" {
+		logger.Fatal("--populator-image is required with --controller.")
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(master, kubeconfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("Failed to build the kubernetes client configuration.")
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("Failed to create the kubernetes client.")
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("Failed to create the dynamic client of the volume populator.")
+	}
+	populator.NewController(logger, kubeClient, dynamicClient, config).Run(signals.SetupSignalHandler())
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/instance/metadata"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 )
@@ -74,6 +75,9 @@ type CloudProvider struct {
 	instanceCache cache.Store
 	metricPusher  *metrics.MetricPusher
 
+	// instanceInventory is nil unless enabled in the cloud-provider config.
+	instanceInventory *instanceInventory
+
 	lbLocks *loadBalancerLocks
 }
 
@@ -98,6 +102,13 @@ func NewCloudProvider(config *providercfg.Config) (cloudprovider.Interface, erro
 		return nil, err
 	}
 
+	if config.Tracing != nil {
+		if _, err := tracing.Setup(context.Background(), "oci-cloud-controller-manager", config.Tracing.TracingConfiguration()); err != nil {
+			return nil, errors.Wrap(err, "enable tracing")
+		}
+		logger.Info("Tracing has been enabled")
+	}
+
 	rateLimiter := client.NewRateLimiter(logger.Sugar(), config.RateLimiter)
 
 	c, err := client.New(logger.Sugar(), cp, &rateLimiter, config)
@@ -173,6 +184,11 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 
 	factory := informers.NewSharedInformerFactory(cp.kubeclient, 5*time.Minute)
 
+	if cp.config.InstanceInventory != nil {
+		cp.instanceInventory = newInstanceInventory(cp.client, cp.inventoryCompartments, cp.inventoryNodeInstances, cp.config.InstanceInventory.ResyncPeriod, cp.logger)
+		cp.client = &inventoryClient{Interface: cp.client, inventory: cp.instanceInventory}
+	}
+
 	nodeInfoController := NewNodeInfoController(
 		factory.Core().V1().Nodes(),
 		cp.kubeclient,
@@ -200,6 +216,10 @@ func (cp *CloudProvider) Initialize(clientBuilder cloudprovider.ControllerClient
 
 	cp.ServiceAccountLister = serviceAccountInformer.Lister()
 
+	if cp.instanceInventory != nil {
+		go cp.instanceInventory.Run(wait.NeverStop)
+	}
+
 	/* StorageBackfillController not applicable for Open Source CCM
 	enableStorageBackfillController := GetIsFeatureEnabledFromEnv(cp.logger, resourceTrackingFeatureFlagName, false)
 	if enableStorageBackfillController {
@@ -237,21 +257,21 @@ func (cp *CloudProvider) ProviderName() string {
 // is supported, false otherwise.
 func (cp *CloudProvider) LoadBalancer() (cloudprovider.LoadBalancer, bool) {
 	cp.logger.Debug("Claiming to support load balancers")
-	return cp, !cp.config.LoadBalancer.Disabled
+	return &tracedLoadBalancer{cp: cp}, !cp.config.LoadBalancer.Disabled
 }
 
 // Instances returns an instances interface. Also returns true if the interface
 // is supported, false otherwise.
 func (cp *CloudProvider) Instances() (cloudprovider.Instances, bool) {
 	cp.logger.Debug("Claiming to support instances")
-	return cp, true
+	return &tracedInstances{cp: cp}, true
 }
 
 // Zones returns a zones interface. Also returns true if the interface is
 // supported, false otherwise.
 func (cp *CloudProvider) Zones() (cloudprovider.Zones, bool) {
 	cp.logger.Debug("Claiming to support zones")
-	return cp, true
+	return &tracedZones{cp: cp}, true
 }
 
 // Clusters returns a clusters interface.  Also returns true if the interface is
@@ -0,0 +1,171 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	cloudprovider "k8s.io/cloud-provider"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
+)
+
+// The traced* types start a span for every call of the cloud provider
+// interfaces. The spans of the OCI API calls made during the call are its
+// children.
+
+func serviceAttributes(service *api.Service) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", service.Namespace),
+		attribute.String("k8s.service.name", service.Name),
+	}
+}
+
+func nodeNameAttribute(name types.NodeName) attribute.KeyValue {
+	return attribute.String("k8s.node.name", string(name))
+}
+
+func providerIDAttribute(providerID string) attribute.KeyValue {
+	return attribute.String("k8s.node.provider_id", providerID)
+}
+
+type tracedLoadBalancer struct {
+	cp *CloudProvider
+}
+
+var _ cloudprovider.LoadBalancer = &tracedLoadBalancer{}
+
+func (l *tracedLoadBalancer) GetLoadBalancer(ctx context.Context, clusterName string, service *api.Service) (*api.LoadBalancerStatus, bool, error) {
+	ctx, span := tracing.Start(ctx, "GetLoadBalancer", serviceAttributes(service)...)
+	status, exists, err := l.cp.GetLoadBalancer(ctx, clusterName, service)
+	tracing.End(span, err)
+	return status, exists, err
+}
+
+func (l *tracedLoadBalancer) GetLoadBalancerName(ctx context.Context, clusterName string, service *api.Service) string {
+	return l.cp.GetLoadBalancerName(ctx, clusterName, service)
+}
+
+func (l *tracedLoadBalancer) EnsureLoadBalancer(ctx context.Context, clusterName string, service *api.Service, nodes []*api.Node) (*api.LoadBalancerStatus, error) {
+	ctx, span := tracing.Start(ctx, "EnsureLoadBalancer", append(serviceAttributes(service), attribute.Int("k8s.nodes", len(nodes)))...)
+	status, err := l.cp.EnsureLoadBalancer(ctx, clusterName, service, nodes)
+	tracing.End(span, err)
+	return status, err
+}
+
+func (l *tracedLoadBalancer) UpdateLoadBalancer(ctx context.Context, clusterName string, service *api.Service, nodes []*api.Node) error {
+	ctx, span := tracing.Start(ctx, "UpdateLoadBalancer", append(serviceAttributes(service), attribute.Int("k8s.nodes", len(nodes)))...)
+	err := l.cp.UpdateLoadBalancer(ctx, clusterName, service, nodes)
+	tracing.End(span, err)
+	return err
+}
+
+func (l *tracedLoadBalancer) EnsureLoadBalancerDeleted(ctx context.Context, clusterName string, service *api.Service) error {
+	ctx, span := tracing.Start(ctx, "EnsureLoadBalancerDeleted", serviceAttributes(service)...)
+	err := l.cp.EnsureLoadBalancerDeleted(ctx, clusterName, service)
+	tracing.End(span, err)
+	return err
+}
+
+type tracedInstances struct {
+	cp *CloudProvider
+}
+
+var _ cloudprovider.Instances = &tracedInstances{}
+
+func (i *tracedInstances) NodeAddresses(ctx context.Context, name types.NodeName) ([]api.NodeAddress, error) {
+	ctx, span := tracing.Start(ctx, "NodeAddresses", nodeNameAttribute(name))
+	addresses, err := i.cp.NodeAddresses(ctx, name)
+	tracing.End(span, err)
+	return addresses, err
+}
+
+func (i *tracedInstances) NodeAddressesByProviderID(ctx context.Context, providerID string) ([]api.NodeAddress, error) {
+	ctx, span := tracing.Start(ctx, "NodeAddressesByProviderID", providerIDAttribute(providerID))
+	addresses, err := i.cp.NodeAddressesByProviderID(ctx, providerID)
+	tracing.End(span, err)
+	return addresses, err
+}
+
+func (i *tracedInstances) InstanceID(ctx context.Context, nodeName types.NodeName) (string, error) {
+	ctx, span := tracing.Start(ctx, "InstanceID", nodeNameAttribute(nodeName))
+	id, err := i.cp.InstanceID(ctx, nodeName)
+	tracing.End(span, err)
+	return id, err
+}
+
+func (i *tracedInstances) InstanceType(ctx context.Context, name types.NodeName) (string, error) {
+	ctx, span := tracing.Start(ctx, "InstanceType", nodeNameAttribute(name))
+	instanceType, err := i.cp.InstanceType(ctx, name)
+	tracing.End(span, err)
+	return instanceType, err
+}
+
+func (i *tracedInstances) InstanceTypeByProviderID(ctx context.Context, providerID string) (string, error) {
+	ctx, span := tracing.Start(ctx, "InstanceTypeByProviderID", providerIDAttribute(providerID))
+	instanceType, err := i.cp.InstanceTypeByProviderID(ctx, providerID)
+	tracing.End(span, err)
+	return instanceType, err
+}
+
+func (i *tracedInstances) AddSSHKeyToAllInstances(ctx context.Context, user string, keyData []byte) error {
+	return i.cp.AddSSHKeyToAllInstances(ctx, user, keyData)
+}
+
+func (i *tracedInstances) CurrentNodeName(ctx context.Context, hostname string) (types.NodeName, error) {
+	return i.cp.CurrentNodeName(ctx, hostname)
+}
+
+func (i *tracedInstances) InstanceExistsByProviderID(ctx context.Context, providerID string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "InstanceExistsByProviderID", providerIDAttribute(providerID))
+	exists, err := i.cp.InstanceExistsByProviderID(ctx, providerID)
+	span.SetAttributes(attribute.Bool("oci.instance.exists", exists))
+	tracing.End(span, err)
+	return exists, err
+}
+
+func (i *tracedInstances) InstanceShutdownByProviderID(ctx context.Context, providerID string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "InstanceShutdownByProviderID", providerIDAttribute(providerID))
+	shutdown, err := i.cp.InstanceShutdownByProviderID(ctx, providerID)
+	tracing.End(span, err)
+	return shutdown, err
+}
+
+type tracedZones struct {
+	cp *CloudProvider
+}
+
+var _ cloudprovider.Zones = &tracedZones{}
+
+func (z *tracedZones) GetZone(ctx context.Context) (cloudprovider.Zone, error) {
+	return z.cp.GetZone(ctx)
+}
+
+func (z *tracedZones) GetZoneByProviderID(ctx context.Context, providerID string) (cloudprovider.Zone, error) {
+	ctx, span := tracing.Start(ctx, "GetZoneByProviderID", providerIDAttribute(providerID))
+	zone, err := z.cp.GetZoneByProviderID(ctx, providerID)
+	tracing.End(span, err)
+	return zone, err
+}
+
+func (z *tracedZones) GetZoneByNodeName(ctx context.Context, nodeName types.NodeName) (cloudprovider.Zone, error) {
+	ctx, span := tracing.Start(ctx, "GetZoneByNodeName", nodeNameAttribute(nodeName))
+	zone, err := z.cp.GetZoneByNodeName(ctx, nodeName)
+	tracing.End(span, err)
+	return zone, err
+}
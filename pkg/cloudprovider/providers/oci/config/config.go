@@ -18,12 +18,14 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/instance/metadata"
 	"io"
 	"os"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/common/auth"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 )
 
 // AuthConfig holds the configuration required for communicating with the OCI
@@ -102,6 +104,75 @@ type RateLimiterConfig struct {
 	DisableRateLimiter   bool    `yaml:"disableRateLimiter"`
 }
 
+// RetryPolicyConfig holds the retry options for a single class of OCI
+// operations. Unset (0) values fall back to the defaults.
+type RetryPolicyConfig struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts uint `yaml:"maxAttempts"`
+	// BaseDelay is the delay before the first retry. It is doubled for every
+	// subsequent retry.
+	BaseDelay time.Duration `yaml:"baseDelay"`
+	// MaxDelay caps the exponential backoff between two attempts.
+	MaxDelay time.Duration `yaml:"maxDelay"`
+	// Jitter randomises every delay by up to the given fraction (0.0 - 1.0)
+	// so that replicas do not retry in lockstep.
+	Jitter float64 `yaml:"jitter"`
+}
+
+// CircuitBreakerConfig holds the options for the per endpoint circuit breaker
+// which fails OCI calls fast while a service is unhealthy.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive 5xx or timeout errors
+	// after which calls to an endpoint are short-circuited.
+	FailureThreshold int `yaml:"failureThreshold"`
+	// OpenDuration is how long the breaker stays open before a single probe
+	// request is let through.
+	OpenDuration time.Duration `yaml:"openDuration"`
+}
+
+// RetryConfig holds the configuration for retrying failed OCI API calls.
+type RetryConfig struct {
+	// Read is applied to the get and list operations.
+	Read *RetryPolicyConfig `yaml:"read"`
+	// Write is applied to the create, update and delete operations.
+	Write *RetryPolicyConfig `yaml:"write"`
+	// +optional
+	// The circuit breaker is enabled when this configuration is provided
+	CircuitBreaker *CircuitBreakerConfig `yaml:"circuitBreaker"`
+}
+
+// InstanceInventoryConfig holds the configuration of the instance inventory,
+// which periodically lists the instances and VNICs of the cluster per
+// compartment and availability domain and serves per node lookups from memory.
+type InstanceInventoryConfig struct {
+	// ResyncPeriod is the interval between two full listings.
+	ResyncPeriod time.Duration `yaml:"resyncPeriod"`
+}
+
+// TracingConfig holds the configuration of the OpenTelemetry traces exported
+// over OTLP to a collector.
+type TracingConfig struct {
+	// +optional
+	// Endpoint of the OTLP gRPC collector, localhost:4317 by default.
+	Endpoint string `yaml:"endpoint"`
+	// +optional
+	// SamplingRatePerMillion is the number of traces sampled per million.
+	// The sampling decision of a parent span is always respected.
+	SamplingRatePerMillion int32 `yaml:"samplingRatePerMillion"`
+}
+
+// TracingConfiguration converts the configuration to the Kubernetes
+// component tracing configuration.
+func (c *TracingConfig) TracingConfiguration() *tracingapi.TracingConfiguration {
+	tracingConfig := &tracingapi.TracingConfiguration{
+		SamplingRatePerMillion: &c.SamplingRatePerMillion,
+	}
+	if c.Endpoint != "" {
+		tracingConfig.Endpoint = &c.Endpoint
+	}
+	return tracingConfig
+}
+
 // MetricsConfig holds the configuration for collection metrics
 // which are pushed to OCI Monitoring. More details present at
 // https://docs.cloud.oracle.com/en-us/iaas/Content/Monitoring/Tasks/publishingcustommetrics.htm
@@ -112,6 +183,74 @@ type MetricsConfig struct {
 	// +optional
 	// This prefix is added to all the metric names
 	Prefix string `yaml:"prefix"`
+	// +optional
+	// Sinks the metrics are pushed to, out of oci, prometheus and log.
+	// Defaults to oci.
+	Sinks []string `yaml:"sinks"`
+	// +optional
+	// BatchSize is the number of buffered metrics which triggers a push.
+	BatchSize int `yaml:"batchSize"`
+	// +optional
+	// FlushInterval is the maximum time metrics are buffered for.
+	FlushInterval time.Duration `yaml:"flushInterval"`
+	// +optional
+	// QueueSize bounds the number of buffered metrics, the oldest metrics
+	// are dropped when it is exceeded.
+	QueueSize int `yaml:"queueSize"`
+}
+
+const (
+	// MetricsSinkOCI pushes metrics to OCI Monitoring.
+	MetricsSinkOCI = "oci"
+	// MetricsSinkPrometheus exposes metrics on the Prometheus endpoint.
+	MetricsSinkPrometheus = "prometheus"
+	// MetricsSinkLog logs metrics.
+	MetricsSinkLog = "log"
+)
+
+// GetSinks returns the configured metric sinks, OCI Monitoring by default.
+func (c *MetricsConfig) GetSinks() []string {
+	if len(c.Sinks) == 0 {
+		return []string{MetricsSinkOCI}
+	}
+	return c.Sinks
+}
+
+// BlockVolumeConfig holds the configuration of the block volume CSI driver.
+type BlockVolumeConfig struct {
+	// ListVolumes enables the ListVolumes RPC of the CSI controller.
+	ListVolumes bool `yaml:"listVolumes"`
+	// +optional
+	// VolumeNamePrefix is the --volume-name-prefix of the external-provisioner,
+	// which identifies the volumes of the cluster by name. Defaults to csi.
+	VolumeNamePrefix string `yaml:"volumeNamePrefix"`
+	// +optional
+	// FreeformTags identify the volumes of the cluster instead of the volume
+	// name prefix when provided. A volume matches if it has all of them.
+	FreeformTags map[string]string `yaml:"freeformTags"`
+}
+
+// DefaultVolumeNamePrefix is the volume name prefix of the block volume
+// external-provisioner in the CSI controller manifest.
+const DefaultVolumeNamePrefix = "csi"
+
+// GetVolumeNamePrefix returns the volume name prefix, csi by default.
+func (c *BlockVolumeConfig) GetVolumeNamePrefix() string {
+	if c.VolumeNamePrefix == "" {
+		return DefaultVolumeNamePrefix
+	}
+	return c.VolumeNamePrefix
+}
+
+// CapacityConfig holds the configuration of the storage capacity reported by
+// the CSI controllers per availability domain, computed from the service
+// limits and quotas of the compartment.
+type CapacityConfig struct {
+	// +optional
+	// HeadroomPercent is the percentage of the limit, or of the quota if
+	// lower, which is reported as used to keep it out of reach of the
+	// provisioning.
+	HeadroomPercent int `yaml:"headroomPercent"`
 }
 
 // TagConfig hold the freeform and defined tags from the cluster level
@@ -135,10 +274,20 @@ type Config struct {
 	Auth         AuthConfig          `yaml:"auth"`
 	LoadBalancer *LoadBalancerConfig `yaml:"loadBalancer"`
 	RateLimiter  *RateLimiterConfig  `yaml:"rateLimiter"`
+	// Retry overrides the default retry behaviour of OCI API calls
+	Retry *RetryConfig `yaml:"retry"`
 	// Metrics collection is enabled when this configuration is provided
 	Metrics *MetricsConfig `yaml:"metrics"`
 	// Tags to be added to managed LB and BV
 	Tags *InitialTags `yaml:"tags"`
+	// The instance inventory is enabled when this configuration is provided
+	InstanceInventory *InstanceInventoryConfig `yaml:"instanceInventory"`
+	// OpenTelemetry tracing is enabled when this configuration is provided
+	Tracing *TracingConfig `yaml:"tracing"`
+	// BlockVolume holds the options of the block volume CSI driver
+	BlockVolume *BlockVolumeConfig `yaml:"blockVolume"`
+	// Storage capacity tracking is enabled when this configuration is provided
+	Capacity *CapacityConfig `yaml:"capacity"`
 
 	RegionKey string `yaml:"regionKey"`
 
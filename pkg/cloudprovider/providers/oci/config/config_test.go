@@ -17,6 +17,7 @@ package config
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/instance/metadata"
 )
@@ -104,6 +105,23 @@ loadBalancer:
   subnet2: ocid1.subnet.oc1.phx.aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
 `
 
+const validConfigWithRetry = `
+useInstancePrincipals: true
+vcn: ocid1.vcn.oc1..
+
+retry:
+  read:
+    maxAttempts: 4
+    baseDelay: 500ms
+    maxDelay: 8s
+    jitter: 0.2
+  write:
+    maxAttempts: 2
+  circuitBreaker:
+    failureThreshold: 5
+    openDuration: 1m
+`
+
 func TestReadConfigShouldSucceedWhenProvidedValidConfig(t *testing.T) {
 	_, err := ReadConfig(strings.NewReader(validConfig))
 	if err != nil {
@@ -169,6 +187,27 @@ func TestLoadBalancingDisabled(t *testing.T) {
 	}
 }
 
+func TestRetryConfig(t *testing.T) {
+	cfg, err := ReadConfig(strings.NewReader(validConfigWithRetry))
+	if err != nil {
+		t.Fatalf("expected no error but got '%v'", err)
+	}
+
+	if cfg.Retry == nil || cfg.Retry.Read == nil || cfg.Retry.Write == nil || cfg.Retry.CircuitBreaker == nil {
+		t.Fatalf("Retry config was not read correctly: cfg.Retry = %+v", cfg.Retry)
+	}
+	if cfg.Retry.Read.MaxAttempts != 4 || cfg.Retry.Read.BaseDelay != 500*time.Millisecond ||
+		cfg.Retry.Read.MaxDelay != 8*time.Second || cfg.Retry.Read.Jitter != 0.2 {
+		t.Errorf("Read retry policy was not set correctly: %+v", cfg.Retry.Read)
+	}
+	if cfg.Retry.Write.MaxAttempts != 2 {
+		t.Errorf("Write retry policy was not set correctly: %+v", cfg.Retry.Write)
+	}
+	if cfg.Retry.CircuitBreaker.FailureThreshold != 5 || cfg.Retry.CircuitBreaker.OpenDuration != time.Minute {
+		t.Errorf("Circuit breaker was not set correctly: %+v", cfg.Retry.CircuitBreaker)
+	}
+}
+
 func TestMetadataSvcSetsOmittedFields(t *testing.T) {
 	mockMetadataSvc := metadata.NewMock(&metadata.InstanceMetadata{
 		Region:              "mockRegion",
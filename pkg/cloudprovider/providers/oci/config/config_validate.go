@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 )
 
 // validateAuthConfig provides basic validation of AuthConfig instances.
@@ -70,6 +71,45 @@ func validateLoadBalancerConfig(c *Config, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validateRetryPolicyConfig provides basic validation of RetryPolicyConfig
+// instances.
+func validateRetryPolicyConfig(c *RetryPolicyConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if c.BaseDelay < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("baseDelay"), c.BaseDelay.String(), "must not be negative"))
+	}
+	if c.MaxDelay < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDelay"), c.MaxDelay.String(), "must not be negative"))
+	}
+	if c.MaxDelay > 0 && c.MaxDelay < c.BaseDelay {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDelay"), c.MaxDelay.String(), "must not be smaller than baseDelay"))
+	}
+	if c.Jitter < 0 || c.Jitter > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitter"), c.Jitter, "must be between 0 and 1"))
+	}
+	return allErrs
+}
+
+// validateRetryConfig provides basic validation of RetryConfig instances.
+func validateRetryConfig(c *RetryConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if c.Read != nil {
+		allErrs = append(allErrs, validateRetryPolicyConfig(c.Read, fldPath.Child("read"))...)
+	}
+	if c.Write != nil {
+		allErrs = append(allErrs, validateRetryPolicyConfig(c.Write, fldPath.Child("write"))...)
+	}
+	if c.CircuitBreaker != nil {
+		if c.CircuitBreaker.FailureThreshold < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("circuitBreaker", "failureThreshold"), c.CircuitBreaker.FailureThreshold, "must not be negative"))
+		}
+		if c.CircuitBreaker.OpenDuration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("circuitBreaker", "openDuration"), c.CircuitBreaker.OpenDuration.String(), "must not be negative"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateConfig validates the OCI Cloud Provider config file.
 func ValidateConfig(c *Config) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -82,21 +122,63 @@ func ValidateConfig(c *Config) field.ErrorList {
 	if c.LoadBalancer != nil && !c.LoadBalancer.Disabled {
 		allErrs = append(allErrs, validateLoadBalancerConfig(c, field.NewPath("loadBalancer"))...)
 	}
+	if c.Retry != nil {
+		allErrs = append(allErrs, validateRetryConfig(c.Retry, field.NewPath("retry"))...)
+	}
+	if c.InstanceInventory != nil && c.InstanceInventory.ResyncPeriod < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("instanceInventory", "resyncPeriod"), c.InstanceInventory.ResyncPeriod.String(), "must not be negative"))
+	}
+	if c.Capacity != nil && (c.Capacity.HeadroomPercent < 0 || c.Capacity.HeadroomPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("capacity", "headroomPercent"), c.Capacity.HeadroomPercent, "must be between 0 and 100"))
+	}
+	if c.Tracing != nil {
+		allErrs = append(allErrs, tracingapi.ValidateTracingConfiguration(c.Tracing.TracingConfiguration(), nil, field.NewPath("tracing"))...)
+	}
 
 	// Validate metric config if the metrics block is not empty
 	if c.Metrics != nil {
-		if len(c.Metrics.CompartmentID) == 0 {
+		allErrs = append(allErrs, validateMetricsConfig(c.Metrics, field.NewPath("metrics"))...)
+	}
+	return allErrs
+}
+
+func validateMetricsConfig(c *MetricsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	ociSink := false
+	for i, sink := range c.GetSinks() {
+		switch sink {
+		case MetricsSinkOCI:
+			ociSink = true
+		case MetricsSinkPrometheus, MetricsSinkLog:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sinks").Index(i), sink,
+				[]string{MetricsSinkOCI, MetricsSinkPrometheus, MetricsSinkLog}))
+		}
+	}
+
+	if ociSink {
+		if len(c.CompartmentID) == 0 {
 			// The compartment is required for pushing metrics to OCI Monitoring
-			allErrs = append(allErrs, field.Required(field.NewPath("metrics", "compartment"), "Compartment is required for pushing custom metrics"))
+			allErrs = append(allErrs, field.Required(fldPath.Child("compartment"), "Compartment is required for pushing custom metrics"))
 		}
 
-		if len(c.Metrics.Namespace) == 0 {
-			allErrs = append(allErrs, field.Required(field.NewPath("metrics", "namespace"), "Metric namespace is required for pushing custom metrics"))
+		if len(c.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "Metric namespace is required for pushing custom metrics"))
 		}
 
-		if len(c.Metrics.ResourceGroup) == 0 {
-			allErrs = append(allErrs, field.Required(field.NewPath("metrics", "resourceGroup"), "Metric resource group is required for pushing custom metrics"))
+		if len(c.ResourceGroup) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup"), "Metric resource group is required for pushing custom metrics"))
 		}
 	}
+
+	if c.BatchSize < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), c.BatchSize, "must not be negative"))
+	}
+	if c.FlushInterval < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flushInterval"), c.FlushInterval.String(), "must not be negative"))
+	}
+	if c.QueueSize < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("queueSize"), c.QueueSize, "must not be negative"))
+	}
 	return allErrs
 }
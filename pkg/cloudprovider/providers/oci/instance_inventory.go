@@ -0,0 +1,475 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+const (
+	instanceInventoryResyncPeriodDefault = 5 * time.Minute
+	// VNICs are only re-read when their attachment changes or when the cached
+	// copy is older than this, e.g. to pick up a reassigned public IP.
+	instanceInventoryVNICMaxAge = time.Hour
+
+	inventoryIPIndex          = "ip"
+	inventoryDisplayNameIndex = "displayName"
+)
+
+// inventoryEntry is the cached state of a single instance.
+type inventoryEntry struct {
+	instanceID     string
+	instance       *core.Instance
+	primaryVNIC    *core.Vnic
+	secondaryVNICs []*core.Vnic
+	// vnicsFetchedAt is zero if the VNICs of the instance are not known.
+	vnicsFetchedAt time.Time
+}
+
+func inventoryKeyFn(obj interface{}) (string, error) {
+	return obj.(*inventoryEntry).instanceID, nil
+}
+
+func inventoryIPIndexFn(obj interface{}) ([]string, error) {
+	entry := obj.(*inventoryEntry)
+	if entry.primaryVNIC == nil {
+		return nil, nil
+	}
+	var ips []string
+	if entry.primaryVNIC.PrivateIp != nil && *entry.primaryVNIC.PrivateIp != "" {
+		ips = append(ips, *entry.primaryVNIC.PrivateIp)
+	}
+	if entry.primaryVNIC.PublicIp != nil && *entry.primaryVNIC.PublicIp != "" {
+		ips = append(ips, *entry.primaryVNIC.PublicIp)
+	}
+	ips = append(ips, entry.primaryVNIC.Ipv6Addresses...)
+	return ips, nil
+}
+
+func inventoryDisplayNameIndexFn(obj interface{}) ([]string, error) {
+	entry := obj.(*inventoryEntry)
+	if entry.instance == nil || entry.instance.DisplayName == nil {
+		return nil, nil
+	}
+	return []string{*entry.instance.DisplayName}, nil
+}
+
+// instanceInventory is an informer-style cache of the instances, VNICs and IPs
+// of the cluster. It periodically lists the instances and VNIC attachments of
+// every compartment and availability domain hosting nodes, so that per node
+// lookups do not spend the OCI rate limit budget. Only the VNICs of the
+// instances backing nodes are read, the compartments may host many other
+// instances. A lookup which misses the cache falls through to the wrapped
+// compute client and refreshes the entry.
+//
+// instanceInventory implements client.ComputeInterface; the calls it does not
+// override go straight to the wrapped client.
+type instanceInventory struct {
+	client.ComputeInterface
+
+	ociClient    client.Interface
+	compartments func() sets.String
+	// nodeInstances returns the IDs of the instances backing nodes
+	nodeInstances func() sets.String
+	resyncPeriod  time.Duration
+	logger        *zap.SugaredLogger
+
+	indexer cache.Indexer
+
+	// availabilityDomains caches the availability domains per compartment.
+	adLock              sync.Mutex
+	availabilityDomains map[string][]string
+}
+
+func newInstanceInventory(ociClient client.Interface, compartments, nodeInstances func() sets.String, resyncPeriod time.Duration, logger *zap.SugaredLogger) *instanceInventory {
+	if resyncPeriod == 0 {
+		resyncPeriod = instanceInventoryResyncPeriodDefault
+	}
+	return &instanceInventory{
+		ComputeInterface: ociClient.Compute(),
+		ociClient:        ociClient,
+		compartments:     compartments,
+		nodeInstances:    nodeInstances,
+		resyncPeriod:     resyncPeriod,
+		logger:           logger.With("component", "instance-inventory"),
+		indexer: cache.NewIndexer(inventoryKeyFn, cache.Indexers{
+			inventoryIPIndex:          inventoryIPIndexFn,
+			inventoryDisplayNameIndex: inventoryDisplayNameIndexFn,
+		}),
+		availabilityDomains: map[string][]string{},
+	}
+}
+
+// Run resyncs the inventory every resyncPeriod until stopCh is closed.
+func (inv *instanceInventory) Run(stopCh <-chan struct{}) {
+	inv.logger.With("resyncPeriod", inv.resyncPeriod).Info("Starting instance inventory")
+	wait.Until(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), inv.resyncPeriod)
+		defer cancel()
+		if err := inv.resync(ctx); err != nil {
+			inv.logger.With(zap.Error(err)).Error("Failed to resync instance inventory")
+		}
+	}, inv.resyncPeriod, stopCh)
+}
+
+// resync lists all instances and VNIC attachments and replaces the content of
+// the inventory. Entries of compartments or availability domains which could
+// not be listed are kept as they are.
+func (inv *instanceInventory) resync(ctx context.Context) error {
+	start := time.Now()
+	entries := map[string]*inventoryEntry{}
+	nodeInstances := inv.nodeInstances()
+	var errs []error
+
+	for _, compartmentID := range inv.compartments().List() {
+		ads, err := inv.getAvailabilityDomains(ctx, compartmentID)
+		if err != nil {
+			errs = append(errs, err)
+			inv.keepEntries(entries, compartmentID, "")
+			continue
+		}
+		for _, ad := range ads {
+			if err := inv.listCompartmentAndAD(ctx, compartmentID, ad, nodeInstances, entries); err != nil {
+				errs = append(errs, err)
+				inv.keepEntries(entries, compartmentID, ad)
+			}
+		}
+	}
+
+	list := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		list = append(list, entry)
+	}
+	if err := inv.indexer.Replace(list, ""); err != nil {
+		return errors.Wrap(err, "replacing instance inventory")
+	}
+
+	inv.logger.With("instances", len(list), "duration", time.Since(start)).Debug("Resynced instance inventory")
+	if len(errs) > 0 {
+		return errors.Errorf("listing instances: %v", errs)
+	}
+	return nil
+}
+
+func (inv *instanceInventory) listCompartmentAndAD(ctx context.Context, compartmentID, ad string, nodeInstances sets.String, entries map[string]*inventoryEntry) error {
+	instances, err := inv.ComputeInterface.ListInstancesByCompartmentAndAD(ctx, compartmentID, ad)
+	if err != nil {
+		return errors.Wrapf(err, "ListInstancesByCompartmentAndAD %s %s", compartmentID, ad)
+	}
+	attachments, err := inv.ComputeInterface.ListVnicAttachmentsByCompartmentAndAD(ctx, compartmentID, ad)
+	if err != nil {
+		return errors.Wrapf(err, "ListVnicAttachmentsByCompartmentAndAD %s %s", compartmentID, ad)
+	}
+
+	vnicIDs := map[string][]string{}
+	for _, attachment := range attachments {
+		if attachment.LifecycleState != core.VnicAttachmentLifecycleStateAttached ||
+			attachment.VnicId == nil || attachment.InstanceId == nil {
+			continue
+		}
+		vnicIDs[*attachment.InstanceId] = append(vnicIDs[*attachment.InstanceId], *attachment.VnicId)
+	}
+
+	for i := range instances {
+		instance := instances[i]
+		if instance.Id == nil {
+			continue
+		}
+		entry := &inventoryEntry{instanceID: *instance.Id, instance: &instance}
+		entries[*instance.Id] = entry
+		if !nodeInstances.Has(*instance.Id) {
+			continue
+		}
+		if err := inv.fillVNICs(ctx, entry, vnicIDs[*instance.Id]); err != nil {
+			// The instance is still cached, its VNICs are looked up on demand.
+			inv.logger.With(zap.Error(err), "instanceID", *instance.Id).Warn("Failed to get VNICs for instance")
+		}
+	}
+	return nil
+}
+
+// fillVNICs sets the VNICs of entry, re-using the cached VNICs if the set of
+// attached VNICs did not change and the cached copies are recent enough.
+func (inv *instanceInventory) fillVNICs(ctx context.Context, entry *inventoryEntry, vnicIDs []string) error {
+	if cached, ok := inv.get(entry.instanceID); ok && !cached.vnicsFetchedAt.IsZero() &&
+		time.Since(cached.vnicsFetchedAt) < instanceInventoryVNICMaxAge &&
+		sets.NewString(vnicIDs...).Equal(cachedVNICIDs(cached)) {
+		entry.primaryVNIC = cached.primaryVNIC
+		entry.secondaryVNICs = cached.secondaryVNICs
+		entry.vnicsFetchedAt = cached.vnicsFetchedAt
+		return nil
+	}
+
+	var secondaryVNICs []*core.Vnic
+	for _, vnicID := range vnicIDs {
+		vnic, err := inv.ociClient.Networking(nil).GetVNIC(ctx, vnicID)
+		if err != nil {
+			return err
+		}
+		if vnic.IsPrimary != nil && *vnic.IsPrimary {
+			entry.primaryVNIC = vnic
+		} else {
+			secondaryVNICs = append(secondaryVNICs, vnic)
+		}
+	}
+	entry.secondaryVNICs = secondaryVNICs
+	entry.vnicsFetchedAt = time.Now()
+	return nil
+}
+
+func cachedVNICIDs(entry *inventoryEntry) sets.String {
+	ids := sets.NewString()
+	if entry.primaryVNIC != nil && entry.primaryVNIC.Id != nil {
+		ids.Insert(*entry.primaryVNIC.Id)
+	}
+	for _, vnic := range entry.secondaryVNICs {
+		if vnic.Id != nil {
+			ids.Insert(*vnic.Id)
+		}
+	}
+	return ids
+}
+
+// keepEntries copies the cached entries of the given compartment (and
+// availability domain, if set) into entries.
+func (inv *instanceInventory) keepEntries(entries map[string]*inventoryEntry, compartmentID, ad string) {
+	for _, obj := range inv.indexer.List() {
+		entry := obj.(*inventoryEntry)
+		if entry.instance == nil || entry.instance.CompartmentId == nil || *entry.instance.CompartmentId != compartmentID {
+			continue
+		}
+		if ad != "" && (entry.instance.AvailabilityDomain == nil || *entry.instance.AvailabilityDomain != ad) {
+			continue
+		}
+		entries[entry.instanceID] = entry
+	}
+}
+
+func (inv *instanceInventory) getAvailabilityDomains(ctx context.Context, compartmentID string) ([]string, error) {
+	inv.adLock.Lock()
+	defer inv.adLock.Unlock()
+
+	if ads, ok := inv.availabilityDomains[compartmentID]; ok {
+		return ads, nil
+	}
+	availabilityDomains, err := inv.ociClient.Identity(nil).ListAvailabilityDomains(ctx, compartmentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ListAvailabilityDomains %s", compartmentID)
+	}
+	var ads []string
+	for _, ad := range availabilityDomains {
+		if ad.Name != nil {
+			ads = append(ads, *ad.Name)
+		}
+	}
+	inv.availabilityDomains[compartmentID] = ads
+	return ads, nil
+}
+
+func (inv *instanceInventory) get(instanceID string) (*inventoryEntry, bool) {
+	obj, exists, err := inv.indexer.GetByKey(instanceID)
+	if err != nil || !exists {
+		return nil, false
+	}
+	return obj.(*inventoryEntry), true
+}
+
+// update applies fn to a copy of the entry of the given instance and stores it.
+func (inv *instanceInventory) update(instanceID string, fn func(entry *inventoryEntry)) {
+	entry := &inventoryEntry{instanceID: instanceID}
+	if cached, ok := inv.get(instanceID); ok {
+		copied := *cached
+		entry = &copied
+	}
+	fn(entry)
+	if err := inv.indexer.Update(entry); err != nil {
+		inv.logger.With(zap.Error(err), "instanceID", instanceID).Warn("Failed to update instance inventory")
+	}
+}
+
+// GetInstance returns the cached instance or gets it from OCI on a miss.
+func (inv *instanceInventory) GetInstance(ctx context.Context, id string) (*core.Instance, error) {
+	if entry, ok := inv.get(id); ok && entry.instance != nil {
+		return entry.instance, nil
+	}
+	instance, err := inv.ComputeInterface.GetInstance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	inv.update(id, func(entry *inventoryEntry) { entry.instance = instance })
+	return instance, nil
+}
+
+// GetInstanceByNodeName looks the instance up by display name or IP address
+// and falls back to the (expensive) OCI lookup on a miss.
+func (inv *instanceInventory) GetInstanceByNodeName(ctx context.Context, compartmentID, vcnID, nodeName string) (*core.Instance, error) {
+	if instance := inv.getInstanceByNodeName(ctx, compartmentID, vcnID, nodeName); instance != nil {
+		return instance, nil
+	}
+	instance, err := inv.ComputeInterface.GetInstanceByNodeName(ctx, compartmentID, vcnID, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	inv.update(*instance.Id, func(entry *inventoryEntry) { entry.instance = instance })
+	return instance, nil
+}
+
+func (inv *instanceInventory) getInstanceByNodeName(ctx context.Context, compartmentID, vcnID, nodeName string) *core.Instance {
+	if instance := inv.uniqueInstance(inventoryDisplayNameIndex, nodeName, compartmentID); instance != nil {
+		return instance
+	}
+
+	// Matching by IP requires the VNIC to be in the cluster's VCN, as in
+	// client.GetInstanceByNodeName. Subnets are served from the subnet cache.
+	for _, ip := range []string{nodeName, strings.ReplaceAll(nodeName, "-", ":")} {
+		instance := inv.uniqueInstance(inventoryIPIndex, ip, compartmentID)
+		if instance == nil {
+			continue
+		}
+		entry, ok := inv.get(*instance.Id)
+		if !ok || entry.primaryVNIC == nil || entry.primaryVNIC.SubnetId == nil {
+			return nil
+		}
+		subnet, err := inv.ociClient.Networking(nil).GetSubnet(ctx, *entry.primaryVNIC.SubnetId)
+		if err != nil || subnet.VcnId == nil || *subnet.VcnId != vcnID {
+			return nil
+		}
+		return instance
+	}
+	return nil
+}
+
+// uniqueInstance returns the only non terminal instance of the compartment
+// matching the index value, or nil.
+func (inv *instanceInventory) uniqueInstance(index, value, compartmentID string) *core.Instance {
+	objs, err := inv.indexer.ByIndex(index, value)
+	if err != nil {
+		return nil
+	}
+	var found *core.Instance
+	for _, obj := range objs {
+		entry := obj.(*inventoryEntry)
+		if entry.instance == nil || entry.instance.CompartmentId == nil || *entry.instance.CompartmentId != compartmentID ||
+			client.IsInstanceInTerminalState(entry.instance) {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = entry.instance
+	}
+	return found
+}
+
+// GetPrimaryVNICForInstance returns the cached primary VNIC or gets it from
+// OCI on a miss.
+func (inv *instanceInventory) GetPrimaryVNICForInstance(ctx context.Context, compartmentID, instanceID string) (*core.Vnic, error) {
+	if entry, ok := inv.get(instanceID); ok && entry.primaryVNIC != nil {
+		return entry.primaryVNIC, nil
+	}
+	vnic, err := inv.ComputeInterface.GetPrimaryVNICForInstance(ctx, compartmentID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	inv.update(instanceID, func(entry *inventoryEntry) { entry.primaryVNIC = vnic })
+	return vnic, nil
+}
+
+// GetSecondaryVNICsForInstance returns the cached secondary VNICs or gets them
+// from OCI if the VNICs of the instance are not known.
+func (inv *instanceInventory) GetSecondaryVNICsForInstance(ctx context.Context, compartmentID, instanceID string) ([]*core.Vnic, error) {
+	if entry, ok := inv.get(instanceID); ok && !entry.vnicsFetchedAt.IsZero() {
+		return entry.secondaryVNICs, nil
+	}
+	return inv.ComputeInterface.GetSecondaryVNICsForInstance(ctx, compartmentID, instanceID)
+}
+
+// inventoryClient is a client.Interface which serves compute lookups from the
+// instance inventory.
+type inventoryClient struct {
+	client.Interface
+	inventory *instanceInventory
+}
+
+func (c *inventoryClient) Compute() client.ComputeInterface {
+	return c.inventory
+}
+
+// inventoryCompartments returns the compartments hosting the cluster's nodes.
+func (cp *CloudProvider) inventoryCompartments() sets.String {
+	compartments := sets.NewString()
+	if cp.config.CompartmentID != "" {
+		compartments.Insert(cp.config.CompartmentID)
+	}
+	if cp.NodeLister == nil {
+		return compartments
+	}
+	nodes, err := cp.NodeLister.List(labels.Everything())
+	if err != nil {
+		cp.logger.With(zap.Error(err)).Error("Failed to list nodes for the instance inventory")
+		return compartments
+	}
+	for _, node := range nodes {
+		if compartmentID := node.Annotations[CompartmentIDAnnotation]; compartmentID != "" {
+			compartments.Insert(compartmentID)
+		}
+	}
+	return compartments
+}
+
+// inventoryNodeInstances returns the IDs of the instances backing the
+// cluster's nodes, from their provider IDs.
+func (cp *CloudProvider) inventoryNodeInstances() sets.String {
+	instances := sets.NewString()
+	if cp.NodeLister == nil {
+		return instances
+	}
+	nodes, err := cp.NodeLister.List(labels.Everything())
+	if err != nil {
+		cp.logger.With(zap.Error(err)).Error("Failed to list nodes for the instance inventory")
+		return instances
+	}
+	for _, node := range nodes {
+		if node.Spec.ProviderID == "" {
+			continue
+		}
+		if instanceID, err := MapProviderIDToResourceID(node.Spec.ProviderID); err == nil {
+			instances.Insert(instanceID)
+		}
+	}
+	return instances
+}
+
+// uncachedCompute returns the compute client bypassing the instance inventory,
+// for lookups which must reflect the current state of an instance.
+func (cp *CloudProvider) uncachedCompute() client.ComputeInterface {
+	if cp.instanceInventory != nil {
+		return cp.instanceInventory.ComputeInterface
+	}
+	return cp.client.Compute()
+}
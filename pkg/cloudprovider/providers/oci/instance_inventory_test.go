@@ -0,0 +1,211 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+type inventoryTestComputeClient struct {
+	MockComputeClient
+	instances   []core.Instance
+	attachments []core.VnicAttachment
+	getCalls    int
+}
+
+func (c *inventoryTestComputeClient) ListInstancesByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.Instance, error) {
+	return c.instances, nil
+}
+
+func (c *inventoryTestComputeClient) ListVnicAttachmentsByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.VnicAttachment, error) {
+	return c.attachments, nil
+}
+
+func (c *inventoryTestComputeClient) GetInstance(ctx context.Context, id string) (*core.Instance, error) {
+	c.getCalls++
+	return &core.Instance{Id: common.String(id), CompartmentId: common.String("compartment")}, nil
+}
+
+type inventoryTestIdentityClient struct {
+	MockIdentityClient
+}
+
+func (inventoryTestIdentityClient) ListAvailabilityDomains(ctx context.Context, compartmentID string) ([]identity.AvailabilityDomain, error) {
+	return []identity.AvailabilityDomain{{Name: common.String("AD-1")}}, nil
+}
+
+type inventoryTestNetworkingClient struct {
+	MockVirtualNetworkClient
+	getVNICCalls int
+}
+
+func (c *inventoryTestNetworkingClient) GetVNIC(ctx context.Context, id string) (*core.Vnic, error) {
+	c.getVNICCalls++
+	return &core.Vnic{
+		Id:        common.String(id),
+		IsPrimary: common.Bool(true),
+		PrivateIp: common.String("10.0.0.10"),
+		SubnetId:  common.String("one"),
+	}, nil
+}
+
+type inventoryTestOCIClient struct {
+	MockOCIClient
+	compute    *inventoryTestComputeClient
+	networking *inventoryTestNetworkingClient
+}
+
+func (c *inventoryTestOCIClient) Compute() client.ComputeInterface {
+	return c.compute
+}
+
+func (c *inventoryTestOCIClient) Networking(ociClientConfig *client.OCIClientConfig) client.NetworkingInterface {
+	return c.networking
+}
+
+func (c *inventoryTestOCIClient) Identity(ociClientConfig *client.OCIClientConfig) client.IdentityInterface {
+	return inventoryTestIdentityClient{}
+}
+
+func (c *inventoryTestOCIClient) Limits() client.LimitsInterface {
+	return nil
+}
+
+func newTestInstanceInventory() (*instanceInventory, *inventoryTestOCIClient) {
+	ociClient := &inventoryTestOCIClient{
+		compute: &inventoryTestComputeClient{
+			instances: []core.Instance{{
+				Id:                 common.String("instance1"),
+				DisplayName:        common.String("node1"),
+				CompartmentId:      common.String("compartment"),
+				AvailabilityDomain: common.String("AD-1"),
+				LifecycleState:     core.InstanceLifecycleStateRunning,
+			}, {
+				Id:                 common.String("other"),
+				DisplayName:        common.String("other"),
+				CompartmentId:      common.String("compartment"),
+				AvailabilityDomain: common.String("AD-1"),
+				LifecycleState:     core.InstanceLifecycleStateRunning,
+			}},
+			attachments: []core.VnicAttachment{{
+				InstanceId:     common.String("instance1"),
+				VnicId:         common.String("vnic1"),
+				LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+			}, {
+				InstanceId:     common.String("other"),
+				VnicId:         common.String("vnic2"),
+				LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+			}},
+		},
+		networking: &inventoryTestNetworkingClient{},
+	}
+	compartments := func() sets.String { return sets.NewString("compartment") }
+	// other is not a node, its VNICs are not read
+	nodeInstances := func() sets.String { return sets.NewString("instance1") }
+	return newInstanceInventory(ociClient, compartments, nodeInstances, 0, zap.S()), ociClient
+}
+
+func TestInstanceInventoryResync(t *testing.T) {
+	inv, ociClient := newTestInstanceInventory()
+	ctx := context.Background()
+
+	if err := inv.resync(ctx); err != nil {
+		t.Fatalf("unexpected resync error: %v", err)
+	}
+
+	instance, err := inv.GetInstance(ctx, "instance1")
+	if err != nil || *instance.DisplayName != "node1" {
+		t.Fatalf("GetInstance() = %v, %v ; wanted node1", instance, err)
+	}
+	if ociClient.compute.getCalls != 0 {
+		t.Errorf("expected GetInstance to be served from the inventory")
+	}
+
+	vnic, err := inv.GetPrimaryVNICForInstance(ctx, "compartment", "instance1")
+	if err != nil || *vnic.Id != "vnic1" {
+		t.Errorf("GetPrimaryVNICForInstance() = %v, %v ; wanted vnic1", vnic, err)
+	}
+
+	if entry, ok := inv.get("other"); !ok || entry.primaryVNIC != nil || !entry.vnicsFetchedAt.IsZero() {
+		t.Errorf("expected the VNICs of an instance without node not to be read but got %+v", entry)
+	}
+
+	// unchanged VNIC attachments are not read again
+	if err := inv.resync(ctx); err != nil {
+		t.Fatalf("unexpected resync error: %v", err)
+	}
+	if ociClient.networking.getVNICCalls != 1 {
+		t.Errorf("expected the VNIC to be read once but got %d calls", ociClient.networking.getVNICCalls)
+	}
+}
+
+func TestInstanceInventoryMiss(t *testing.T) {
+	inv, ociClient := newTestInstanceInventory()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := inv.GetInstance(ctx, "instance2"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if ociClient.compute.getCalls != 1 {
+		t.Errorf("expected a miss to refresh the inventory but got %d GetInstance calls", ociClient.compute.getCalls)
+	}
+}
+
+func TestInstanceInventoryGetInstanceByNodeName(t *testing.T) {
+	inv, _ := newTestInstanceInventory()
+	ctx := context.Background()
+	if err := inv.resync(ctx); err != nil {
+		t.Fatalf("unexpected resync error: %v", err)
+	}
+	inv.update("nosubnet", func(entry *inventoryEntry) {
+		entry.instance = &core.Instance{Id: common.String("nosubnet"), CompartmentId: common.String("compartment")}
+		entry.primaryVNIC = &core.Vnic{Id: common.String("vnic3"), PrivateIp: common.String("10.0.0.11")}
+	})
+
+	testCases := map[string]struct {
+		nodeName string
+		vcnID    string
+		expected string
+	}{
+		"display name":              {nodeName: "node1", vcnID: "vcnwithoutdnslabel", expected: "instance1"},
+		"private ip":                {nodeName: "10.0.0.10", vcnID: "vcnwithoutdnslabel", expected: "instance1"},
+		"ip of other vcn":           {nodeName: "10.0.0.10", vcnID: "other", expected: ""},
+		"unknown node":              {nodeName: "node2", vcnID: "vcnwithoutdnslabel", expected: ""},
+		"ip of vnic without subnet": {nodeName: "10.0.0.11", vcnID: "vcnwithoutdnslabel", expected: ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := inv.getInstanceByNodeName(ctx, "compartment", tc.vcnID, tc.nodeName)
+			result := ""
+			if instance != nil {
+				result = *instance.Id
+			}
+			if result != tc.expected {
+				t.Errorf("getInstanceByNodeName() = %q ; wanted %q", result, tc.expected)
+			}
+		})
+	}
+}
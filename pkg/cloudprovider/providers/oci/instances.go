@@ -358,7 +358,13 @@ func (cp *CloudProvider) InstanceExistsByProviderID(ctx context.Context, provide
 	if err != nil {
 		return false, err
 	}
-	instance, err := cp.client.Compute().GetInstance(ctx, resourceID)
+	// A terminal state is final, so a terminated instance in the inventory does not need to be looked up again.
+	if cp.instanceInventory != nil {
+		if entry, ok := cp.instanceInventory.get(resourceID); ok && entry.instance != nil && client.IsInstanceInTerminalState(entry.instance) {
+			return false, nil
+		}
+	}
+	instance, err := cp.uncachedCompute().GetInstance(ctx, resourceID)
 	if client.IsNotFound(err) {
 		return cp.checkForAuthorizationError(ctx, providerID)
 	}
@@ -378,7 +384,7 @@ func (cp *CloudProvider) checkForAuthorizationError(ctx context.Context, instanc
 	// to eliminate AD specific issues, list all ADs and make AD specific requests
 	availabilityDomains, err := cp.client.Identity(nil).ListAvailabilityDomains(ctx, compartmentId)
 	for _, availabilityDomain := range availabilityDomains {
-		instances, err := cp.client.Compute().ListInstancesByCompartmentAndAD(ctx, compartmentId, *availabilityDomain.Name)
+		instances, err := cp.uncachedCompute().ListInstancesByCompartmentAndAD(ctx, compartmentId, *availabilityDomain.Name)
 		// if we are getting errors for ListInstances the issue can be authorization or other issues
 		// so to be safe we return the error back as we can't list instances in the compartment
 		if err != nil {
@@ -406,7 +412,7 @@ func (cp *CloudProvider) InstanceShutdownByProviderID(ctx context.Context, provi
 		return false, err
 	}
 
-	instance, err := cp.client.Compute().GetInstance(ctx, resourceID)
+	instance, err := cp.uncachedCompute().GetInstance(ctx, resourceID)
 	if err != nil {
 		return false, err
 	}
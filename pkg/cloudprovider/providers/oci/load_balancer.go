@@ -565,6 +565,13 @@ func checkSubnetIpFamilyCompatibility(subnets []*core.Subnet, ipVersion string)
 // EnsureLoadBalancer creates a new load balancer or updates the existing one.
 // Returns the status of the balancer (i.e it's public IP address if one exists).
 func (cp *CloudProvider) EnsureLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, clusterNodes []*v1.Node) (*v1.LoadBalancerStatus, error) {
+	startTime := time.Now()
+	status, err := cp.ensureLoadBalancer(ctx, clusterName, service, clusterNodes)
+	observeLBReconcile(cp.logger, service, lbReconcileEnsure, startTime, err)
+	return status, err
+}
+
+func (cp *CloudProvider) ensureLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, clusterNodes []*v1.Node) (*v1.LoadBalancerStatus, error) {
 	startTime := time.Now()
 	lbName := GetLoadBalancerName(service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -1086,6 +1093,7 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			if err != nil {
 				return errors.Wrap(err, "updating BackendSet")
 			}
+			incLBAction(spec.service, "backend_set", a.Type())
 		case *ListenerAction:
 			backendSetName := *a.Listener.DefaultBackendSetName
 			var ports portSpec
@@ -1103,11 +1111,13 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancer(ctx context.Context, lb
 			if err != nil {
 				return errors.Wrap(err, "updating listener")
 			}
+			incLBAction(spec.service, "listener", a.Type())
 		case *RuleSetAction:
 			err := clb.updateRuleSet(ctx, lbID, a, spec)
 			if err != nil {
 				return errors.Wrap(err, "updating RuleSet")
 			}
+			incLBAction(spec.service, "rule_set", a.Type())
 		}
 	}
 
@@ -1204,6 +1214,7 @@ func (clb *CloudLoadBalancerProvider) updateLoadBalancerBackends(ctx context.Con
 				if err != nil {
 					return errors.Wrap(err, "updating BackendSet")
 				}
+				incLBAction(spec.service, "backend_set", a.Type())
 			}
 		}
 	}
@@ -1386,6 +1397,13 @@ func (clb *CloudLoadBalancerProvider) updateRuleSet(ctx context.Context, lbID st
 
 // UpdateLoadBalancer updates an existing loadbalancer
 func (cp *CloudProvider) UpdateLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, nodes []*v1.Node) error {
+	startTime := time.Now()
+	err := cp.updateLoadBalancer(ctx, clusterName, service, nodes)
+	observeLBReconcile(cp.logger, service, lbReconcileUpdate, startTime, err)
+	return err
+}
+
+func (cp *CloudProvider) updateLoadBalancer(ctx context.Context, clusterName string, service *v1.Service, nodes []*v1.Node) error {
 	startTime := time.Now()
 	lbName := GetLoadBalancerName(service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -1594,6 +1612,13 @@ func (cp *CloudProvider) getNodesAndPodsByIPs(ctx context.Context, backendIPs []
 // returning nil if the load balancer specified either didn't exist or was
 // successfully deleted.
 func (cp *CloudProvider) EnsureLoadBalancerDeleted(ctx context.Context, clusterName string, service *v1.Service) error {
+	startTime := time.Now()
+	err := cp.ensureLoadBalancerDeleted(ctx, clusterName, service)
+	observeLBReconcile(cp.logger, service, lbReconcileDelete, startTime, err)
+	return err
+}
+
+func (cp *CloudProvider) ensureLoadBalancerDeleted(ctx context.Context, clusterName string, service *v1.Service) error {
 	startTime := time.Now()
 	name := cp.GetLoadBalancerName(ctx, clusterName, service)
 	loadBalancerType := getLoadBalancerType(service)
@@ -0,0 +1,98 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	lbReconcileEnsure = "ensure"
+	lbReconcileUpdate = "update"
+	lbReconcileDelete = "delete"
+
+	securityRuleKindSecurityList = "security_list"
+	securityRuleKindNSG          = "nsg"
+)
+
+var (
+	lbReconcileDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "oci_load_balancer_reconcile_duration_seconds",
+			Help:           "Duration of the load balancer reconciliations of Services.",
+			Buckets:        metrics.ExponentialBuckets(0.5, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"lb_type", "operation", "result"},
+	)
+	lbActionCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_load_balancer_actions_total",
+			Help:           "Changes applied to the load balancers of Services.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"lb_type", "resource", "action"},
+	)
+	securityRuleCount = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "oci_security_rules",
+			Help:           "Number of rules of the security lists and network security groups managed for load balancers, after their updates.",
+			Buckets:        []float64{10, 25, 50, 100, 150, 200, 300, 500},
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"kind", "direction"},
+	)
+)
+
+// observeLBReconcile records the duration of a load balancer reconciliation
+// started at startTime. The Service is only logged, to keep the cardinality of
+// the metrics independent of the number of Services.
+func observeLBReconcile(logger *zap.SugaredLogger, service *v1.Service, operation string, startTime time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	duration := time.Since(startTime)
+	lbReconcileDuration.WithLabelValues(getLoadBalancerType(service), operation, result).Observe(duration.Seconds())
+	logger.With("namespace", service.Namespace, "serviceName", service.Name, "operation", operation,
+		"result", result, "duration", duration).Debug("Load balancer reconciliation finished")
+}
+
+// incLBAction counts a change applied to the load balancer of a service.
+func incLBAction(service *v1.Service, resource string, action ActionType) {
+	lbActionCounter.WithLabelValues(getLoadBalancerType(service), resource, string(action)).Inc()
+}
+
+// observeSecurityRuleCount records the number of rules of a security list or
+// network security group after an update, and logs it with its OCID.
+func observeSecurityRuleCount(logger *zap.SugaredLogger, kind, id, direction string, count int) {
+	securityRuleCount.WithLabelValues(kind, direction).Observe(float64(count))
+	logger.With("kind", kind, "id", id, "direction", direction, "rules", count).Debug("Security rules updated")
+}
+
+func observeSecurityListRuleCounts(logger *zap.SugaredLogger, id string, ingressRules []core.IngressSecurityRule, egressRules []core.EgressSecurityRule) {
+	observeSecurityRuleCount(logger, securityRuleKindSecurityList, id, "ingress", len(ingressRules))
+	observeSecurityRuleCount(logger, securityRuleKindSecurityList, id, "egress", len(egressRules))
+}
+
+func init() {
+	legacyregistry.MustRegister(lbReconcileDuration, lbActionCounter, securityRuleCount)
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestLBReconcileMetrics(t *testing.T) {
+	service := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "metrics-test",
+		Annotations: map[string]string{ServiceAnnotationLoadBalancerType: NLB}}}
+
+	observeLBReconcile(zap.S(), service, lbReconcileEnsure, time.Now(), nil)
+	observeLBReconcile(zap.S(), service, lbReconcileEnsure, time.Now(), errors.New("failed"))
+	incLBAction(service, "listener", Create)
+
+	count, err := testutil.GetHistogramMetricCount(lbReconcileDuration.WithLabelValues(NLB, lbReconcileEnsure, "error"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 failed reconciliation but got %d", count)
+	}
+	value, err := testutil.GetCounterMetricValue(lbActionCounter.WithLabelValues(NLB, "listener", string(Create)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 1 {
+		t.Errorf("expected 1 listener creation but got %v", value)
+	}
+
+	observeSecurityRuleCount(zap.S(), securityRuleKindNSG, "ocid1.networksecuritygroup.oc1..test", "ingress", 12)
+	count, err = testutil.GetHistogramMetricCount(securityRuleCount.WithLabelValues(securityRuleKindNSG, "ingress"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 security rule update but got %d", count)
+	}
+}
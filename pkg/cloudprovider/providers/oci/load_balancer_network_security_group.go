@@ -354,6 +354,10 @@ func (s *CloudProvider) reconcileSecurityGroup(ctx context.Context, lbservice se
 			return err
 		}
 	}
+	observeSecurityRuleCount(logger, securityRuleKindNSG, *frontendNsg.Id, "ingress",
+		len(existingLbIngressSecurityRules)+len(addLbIngressRules)-len(removeLbIngressRules))
+	observeSecurityRuleCount(logger, securityRuleKindNSG, *frontendNsg.Id, "egress",
+		len(existingLbEgressSecurityRules)+len(addLbEgressRules)-len(removeLbEgressRules))
 
 	for _, nsg := range lbservice.backendNsgOcids {
 		_, err := s.getNsg(ctx, nsg)
@@ -386,6 +390,8 @@ func (s *CloudProvider) reconcileSecurityGroup(ctx context.Context, lbservice se
 				return err
 			}
 		}
+		observeSecurityRuleCount(logger, securityRuleKindNSG, nsg, "ingress",
+			len(existingBackendIngressSecurityRules)+len(addBackendIngressRules)-len(removeBackendIngressRules))
 	}
 	return nil
 }
@@ -128,6 +128,7 @@ func (s *baseSecurityListManager) updateBackendRules(ctx context.Context, lbSubn
 		if err != nil {
 			return errors.Wrapf(err, "update security list rules %q for subnet %q", *secList.Id, *subnet.Id)
 		}
+		observeSecurityListRuleCounts(logger, *secList.Id, ingressRules, secList.EgressSecurityRules)
 	}
 
 	return nil
@@ -172,6 +173,7 @@ func (s *baseSecurityListManager) updateLoadBalancerRules(ctx context.Context, l
 		if err != nil {
 			return errors.Wrapf(err, "update lb security list rules %q for subnet %q", *secList.Id, *lbSubnet.Id)
 		}
+		observeSecurityListRuleCounts(logger, *secList.Id, lbIngressRules, lbEgressRules)
 	}
 
 	return nil
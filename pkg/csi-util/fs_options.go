@@ -0,0 +1,241 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi_util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// optionValue is the kind of value an mkfs or mount option takes.
+type optionValue int
+
+const (
+	// noValue options are flags without a value
+	noValue optionValue = iota
+	// sizeValue options take a number, with an optional unit suffix
+	sizeValue
+	// booleanValue options take 0 or 1
+	booleanValue
+)
+
+var sizeValueRegexp = regexp.MustCompile(`^[0-9]+[kKmMgGsb]?$`)
+
+func (v optionValue) valid(value string) bool {
+	switch v {
+	case sizeValue:
+		return sizeValueRegexp.MatchString(value)
+	case booleanValue:
+		return value == "0" || value == "1"
+	}
+	return false
+}
+
+// mkfsFlag is an allowed mkfs flag, which takes either a value or a comma
+// separated list of suboptions.
+type mkfsFlag struct {
+	value      optionValue
+	suboptions map[string]optionValue
+}
+
+// mkfsFlags are the mkfs flags allowed for each filesystem type. Flags which
+// write to other devices or files, change the labels or UUIDs of the
+// filesystems or conflict with the flags passed by the driver are not allowed.
+var mkfsFlags = map[string]map[string]mkfsFlag{
+	"ext4": ext4MkfsFlags,
+	"ext3": ext4MkfsFlags,
+	"xfs": {
+		"-b": {suboptions: map[string]optionValue{"size": sizeValue}},
+		"-d": {suboptions: map[string]optionValue{"agcount": sizeValue, "agsize": sizeValue, "su": sizeValue, "sw": sizeValue, "sunit": sizeValue, "swidth": sizeValue}},
+		"-i": {suboptions: map[string]optionValue{"size": sizeValue, "maxpct": sizeValue, "sparse": booleanValue}},
+		"-l": {suboptions: map[string]optionValue{"size": sizeValue, "su": sizeValue, "sunit": sizeValue, "lazy-count": booleanValue}},
+		"-m": {suboptions: map[string]optionValue{"reflink": booleanValue, "bigtime": booleanValue, "finobt": booleanValue, "rmapbt": booleanValue, "inobtcount": booleanValue}},
+		"-n": {suboptions: map[string]optionValue{"size": sizeValue}},
+		"-s": {suboptions: map[string]optionValue{"size": sizeValue}},
+		"-K": {value: noValue},
+	},
+}
+
+var ext4MkfsFlags = map[string]mkfsFlag{
+	"-b": {value: sizeValue},
+	"-i": {value: sizeValue},
+	"-I": {value: sizeValue},
+	"-N": {value: sizeValue},
+	"-E": {suboptions: map[string]optionValue{"lazy_itable_init": booleanValue, "lazy_journal_init": booleanValue, "stride": sizeValue, "stripe_width": sizeValue, "stripe-width": sizeValue, "discard": noValue, "nodiscard": noValue}},
+}
+
+// mountOption is an allowed mount option. Mount options of the same group,
+// e.g. noatime and relatime, override each other.
+type mountOption struct {
+	group string
+	value optionValue
+	// values are the allowed values of options which take one of a set of values
+	values []string
+}
+
+var commonMountOptions = map[string]mountOption{
+	"noatime":     {group: "atime"},
+	"relatime":    {group: "atime"},
+	"strictatime": {group: "atime"},
+	"nodiratime":  {group: "diratime"},
+	"lazytime":    {group: "lazytime"},
+	"nolazytime":  {group: "lazytime"},
+	"discard":     {group: "discard"},
+	"nodiscard":   {group: "discard"},
+}
+
+// mountOptions are the mount options allowed for each filesystem type, in
+// addition to the common mount options.
+var mountOptions = map[string]map[string]mountOption{
+	"ext4": {
+		"commit":   {group: "commit", value: sizeValue},
+		"data":     {group: "data", values: []string{"ordered", "journal"}},
+		"usrquota": {group: "usrquota"},
+		"grpquota": {group: "grpquota"},
+		"prjquota": {group: "prjquota"},
+	},
+	"ext3": {
+		"commit":   {group: "commit", value: sizeValue},
+		"data":     {group: "data", values: []string{"ordered", "journal"}},
+		"usrquota": {group: "usrquota"},
+		"grpquota": {group: "grpquota"},
+	},
+	"xfs": {
+		"inode64":   {group: "inode"},
+		"inode32":   {group: "inode"},
+		"largeio":   {group: "largeio"},
+		"nolargeio": {group: "largeio"},
+		"allocsize": {group: "allocsize", value: sizeValue},
+		"logbufs":   {group: "logbufs", value: sizeValue},
+		"logbsize":  {group: "logbsize", value: sizeValue},
+		"uquota":    {group: "usrquota"},
+		"usrquota":  {group: "usrquota"},
+		"gquota":    {group: "grpquota"},
+		"grpquota":  {group: "grpquota"},
+		"pquota":    {group: "prjquota"},
+		"prjquota":  {group: "prjquota"},
+	},
+}
+
+// ValidateMkfsOptions validates the space separated mkfs options against the
+// mkfs flags allowed for the filesystem type and returns them as arguments of
+// mkfs.
+func ValidateMkfsOptions(fsType, options string) ([]string, error) {
+	flags, ok := mkfsFlags[fsType]
+	if !ok {
+		return nil, fmt.Errorf("mkfs options are not supported for fsType %q", fsType)
+	}
+	args := strings.Fields(options)
+	for i := 0; i < len(args); i++ {
+		flag, ok := flags[args[i]]
+		if !ok {
+			return nil, fmt.Errorf("mkfs option %q is not allowed for fsType %q", args[i], fsType)
+		}
+		if flag.suboptions == nil && flag.value == noValue {
+			continue
+		}
+		if i+1 == len(args) {
+			return nil, fmt.Errorf("mkfs option %q requires a value", args[i])
+		}
+		i++
+		if flag.suboptions == nil {
+			if !flag.value.valid(args[i]) {
+				return nil, fmt.Errorf("invalid value %q of mkfs option %q", args[i], args[i-1])
+			}
+			continue
+		}
+		for _, suboption := range strings.Split(args[i], ",") {
+			name, value, hasValue := strings.Cut(suboption, "=")
+			kind, ok := flag.suboptions[name]
+			if !ok {
+				return nil, fmt.Errorf("mkfs option %s %q is not allowed for fsType %q", args[i-1], name, fsType)
+			}
+			if hasValue != (kind != noValue) || (hasValue && !kind.valid(value)) {
+				return nil, fmt.Errorf("invalid mkfs option %s %q", args[i-1], suboption)
+			}
+		}
+	}
+	return args, nil
+}
+
+// ValidateMountOptions validates the comma separated mount options against
+// the mount options allowed for the filesystem type and returns them.
+func ValidateMountOptions(fsType, options string) ([]string, error) {
+	var result []string
+	for _, option := range strings.Split(options, ",") {
+		if option = strings.TrimSpace(option); option == "" {
+			continue
+		}
+		name, value, hasValue := strings.Cut(option, "=")
+		allowed, ok := lookupMountOption(fsType, name)
+		if !ok {
+			return nil, fmt.Errorf("mount option %q is not allowed for fsType %q", name, fsType)
+		}
+		if allowed.values != nil {
+			if !hasValue || !contains(allowed.values, value) {
+				return nil, fmt.Errorf("invalid mount option %q, supported values are %s", option, strings.Join(allowed.values, ", "))
+			}
+		} else if hasValue != (allowed.value != noValue) || (hasValue && !allowed.value.valid(value)) {
+			return nil, fmt.Errorf("invalid mount option %q", option)
+		}
+		result = append(result, option)
+	}
+	return result, nil
+}
+
+// MergeMountOptions returns the mount options followed by the default mount
+// options which are not overridden by a mount option of the same group.
+func MergeMountOptions(fsType string, options, defaultOptions []string) []string {
+	groups := make(map[string]bool, len(options))
+	for _, option := range options {
+		groups[mountOptionGroup(fsType, option)] = true
+	}
+	merged := append([]string{}, options...)
+	for _, option := range defaultOptions {
+		if group := mountOptionGroup(fsType, option); !groups[group] {
+			groups[group] = true
+			merged = append(merged, option)
+		}
+	}
+	return merged
+}
+
+func lookupMountOption(fsType, name string) (mountOption, bool) {
+	if option, ok := commonMountOptions[name]; ok {
+		return option, true
+	}
+	option, ok := mountOptions[fsType][name]
+	return option, ok
+}
+
+// mountOptionGroup returns the group of the mount option, or its name if it
+// is not an allowed mount option.
+func mountOptionGroup(fsType, option string) string {
+	name, _, _ := strings.Cut(option, "=")
+	if allowed, ok := lookupMountOption(fsType, name); ok {
+		return allowed.group
+	}
+	return name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi_util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateMkfsOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsType  string
+		options string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "ext4 extended options and inode ratio",
+			fsType:  "ext4",
+			options: "-E lazy_itable_init=0,lazy_journal_init=0,nodiscard -i 16384",
+			want:    []string{"-E", "lazy_itable_init=0,lazy_journal_init=0,nodiscard", "-i", "16384"},
+		},
+		{
+			name:    "ext3 block size",
+			fsType:  "ext3",
+			options: "-b 4096",
+			want:    []string{"-b", "4096"},
+		},
+		{
+			name:    "xfs reflink and stripe geometry",
+			fsType:  "xfs",
+			options: "-m reflink=1,bigtime=1 -d su=64k,sw=4 -K",
+			want:    []string{"-m", "reflink=1,bigtime=1", "-d", "su=64k,sw=4", "-K"},
+		},
+		{
+			name:    "flag not allowed",
+			fsType:  "ext4",
+			options: "-L data",
+			wantErr: true,
+		},
+		{
+			name:    "xfs external log device",
+			fsType:  "xfs",
+			options: "-l logdev=/dev/sdc",
+			wantErr: true,
+		},
+		{
+			name:    "xfs dry run",
+			fsType:  "xfs",
+			options: "-N",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			fsType:  "ext4",
+			options: "-i",
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			fsType:  "ext4",
+			options: "-i $(reboot)",
+			wantErr: true,
+		},
+		{
+			name:    "invalid boolean suboption",
+			fsType:  "xfs",
+			options: "-m reflink=yes",
+			wantErr: true,
+		},
+		{
+			name:    "suboption without value",
+			fsType:  "ext4",
+			options: "-E stride",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported fsType",
+			fsType:  "btrfs",
+			options: "-b 4096",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateMkfsOptions(tt.fsType, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateMkfsOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateMkfsOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMountOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsType  string
+		options string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "common options",
+			fsType:  "ext4",
+			options: "noatime, discard,",
+			want:    []string{"noatime", "discard"},
+		},
+		{
+			name:    "ext4 options with values",
+			fsType:  "ext4",
+			options: "data=journal,commit=30",
+			want:    []string{"data=journal", "commit=30"},
+		},
+		{
+			name:    "xfs options",
+			fsType:  "xfs",
+			options: "noatime,largeio,logbsize=256k,prjquota",
+			want:    []string{"noatime", "largeio", "logbsize=256k", "prjquota"},
+		},
+		{
+			name:    "xfs option for ext4",
+			fsType:  "ext4",
+			options: "largeio",
+			wantErr: true,
+		},
+		{
+			name:    "option not allowed",
+			fsType:  "ext4",
+			options: "noatime,suid",
+			wantErr: true,
+		},
+		{
+			name:    "value not allowed",
+			fsType:  "ext4",
+			options: "data=writeback",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			fsType:  "xfs",
+			options: "allocsize",
+			wantErr: true,
+		},
+		{
+			name:    "unexpected value",
+			fsType:  "ext4",
+			options: "noatime=1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateMountOptions(tt.fsType, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateMountOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateMountOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeMountOptions(t *testing.T) {
+	tests := []struct {
+		name           string
+		fsType         string
+		options        []string
+		defaultOptions []string
+		want           []string
+	}{
+		{
+			name:    "no default options",
+			fsType:  "ext4",
+			options: []string{"ro"},
+			want:    []string{"ro"},
+		},
+		{
+			name:           "default options appended",
+			fsType:         "ext4",
+			options:        []string{"ro"},
+			defaultOptions: []string{"noatime", "discard"},
+			want:           []string{"ro", "noatime", "discard"},
+		},
+		{
+			name:           "default options overridden by the options",
+			fsType:         "xfs",
+			options:        []string{"relatime", "nodiscard", "logbsize=64k"},
+			defaultOptions: []string{"noatime", "discard", "logbsize=256k", "largeio"},
+			want:           []string{"relatime", "nodiscard", "logbsize=64k", "largeio"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeMountOptions(tt.fsType, tt.options, tt.defaultOptions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeMountOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	snapshotclientset "github.com/kubernetes-csi/external-snapshotter/client/v6/clientset/versioned"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -112,6 +113,7 @@ type NodeMetadata struct {
 	Ipv6Enabled            bool
 	AvailabilityDomain     string
 	FullAvailabilityDomain string
+	Shape                  string
 	IsNodeMetadataLoaded   bool
 }
 
@@ -192,6 +194,8 @@ func (u *Util) LoadNodeMetadataFromApiServer(ctx context.Context, k kubernetes.I
 			nodeMetadata.FullAvailabilityDomain, _ = node.Labels[AvailabilityDomainLabel]
 		}
 
+		nodeMetadata.Shape = node.Labels[kubeAPI.LabelInstanceTypeStable]
+
 		if preferredIpFamily, ok := node.Labels[LabelIpFamilyPreferred]; ok {
 			nodeMetadata.PreferredNodeIpFamily = FormatValidIpStackInK8SConvention(preferredIpFamily)
 		}
@@ -265,9 +269,11 @@ func ExtractISCSIInformation(attributes map[string]string) (*disk.Disk, error) {
 	}
 
 	return &disk.Disk{
-		IQN:     iqn,
-		IscsiIp: iSCSIIp,
-		Port:    nPort,
+		IQN:          iqn,
+		IscsiIp:      iSCSIIp,
+		Port:         nPort,
+		ChapUsername: attributes[disk.ISCSICHAPUSERNAME],
+		ChapSecret:   attributes[disk.ISCSICHAPSECRET],
 	}, nil
 }
 
@@ -308,6 +314,27 @@ func ExtractISCSIInformationFromMountPath(logger *zap.SugaredLogger, diskPath []
 }
 
 func GetKubeClient(logger *zap.SugaredLogger, master, kubeconfig string) *kubernetes.Clientset {
+	kubeClientSet, err := kubernetes.NewForConfig(getKubeConfig(logger, master, kubeconfig))
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("Failed to create a kubernetes clientset.")
+	} else {
+		logger.Info("Created kubernetes client successfully.")
+	}
+	return kubeClientSet
+}
+
+// GetSnapshotClient returns the clientset of the volume snapshot resources.
+func GetSnapshotClient(logger *zap.SugaredLogger, master, kubeconfig string) *snapshotclientset.Clientset {
+	snapshotClientSet, err := snapshotclientset.NewForConfig(getKubeConfig(logger, master, kubeconfig))
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("Failed to create a volume snapshot clientset.")
+	} else {
+		logger.Info("Created volume snapshot client successfully.")
+	}
+	return snapshotClientSet
+}
+
+func getKubeConfig(logger *zap.SugaredLogger, master, kubeconfig string) *rest.Config {
 	var (
 		config *rest.Config
 		err    error
@@ -321,14 +348,7 @@ func GetKubeClient(logger *zap.SugaredLogger, master, kubeconfig string) *kubern
 			logger.With(zap.Error(err)).Fatal("Failed to get the kubeconfig in cluster.")
 		}
 	}
-
-	kubeClientSet, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		logger.With(zap.Error(err)).Fatal("Failed to create a kubernetes clientset.")
-	} else {
-		logger.Info("Created kubernetes client successfully.")
-	}
-	return kubeClientSet
+	return config
 }
 
 // Get the staging target filepath inside the given stagingTargetPath, to be used for raw block volume support
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"go.uber.org/zap"
 	"k8s.io/client-go/kubernetes"
@@ -375,6 +376,7 @@ func Test_LoadNodeMetadataFromApiServer(t *testing.T) {
 			want: &NodeMetadata{
 				PreferredNodeIpFamily: Ipv4Stack,
 				AvailabilityDomain: "PHX-AD-3",
+				Shape:                 "VM.Standard.E4.Flex",
 				Ipv4Enabled:           true,
 				Ipv6Enabled:           true,
 			},
@@ -411,6 +413,7 @@ func Test_LoadNodeMetadataFromApiServer(t *testing.T) {
 			want: &NodeMetadata{
 				PreferredNodeIpFamily: Ipv4Stack,
 				AvailabilityDomain: "PHX-AD-3",
+				Shape:                 "VM.Standard.E4.Flex",
 				Ipv4Enabled:           true,
 				Ipv6Enabled:           true,
 			},
@@ -518,6 +521,56 @@ func Test_ExtractISCSIInformationFromMountPath(t *testing.T) {
 	}
 }
 
+func Test_ExtractISCSIInformation(t *testing.T) {
+	publishContext := map[string]string{
+		disk.ISCSIIQN:  "iqn.2015-12.com.oracleiaas:63a2e76c-5353-4a75-82d0-ee31a39471ca",
+		disk.ISCSIIP:   "169.254.2.2",
+		disk.ISCSIPORT: "3260",
+	}
+	chapPublishContext := map[string]string{
+		disk.ISCSICHAPUSERNAME: "ocid1.volume.oc1.chap",
+		disk.ISCSICHAPSECRET:   "secret",
+	}
+	for k, v := range publishContext {
+		chapPublishContext[k] = v
+	}
+
+	tests := []struct {
+		name           string
+		publishContext map[string]string
+		want           *disk.Disk
+		wantErr        bool
+	}{
+		{
+			name:           "iSCSI target without CHAP",
+			publishContext: publishContext,
+			want:           &disk.Disk{IQN: "iqn.2015-12.com.oracleiaas:63a2e76c-5353-4a75-82d0-ee31a39471ca", IscsiIp: "169.254.2.2", Port: 3260},
+		},
+		{
+			name:           "iSCSI target with CHAP",
+			publishContext: chapPublishContext,
+			want: &disk.Disk{IQN: "iqn.2015-12.com.oracleiaas:63a2e76c-5353-4a75-82d0-ee31a39471ca", IscsiIp: "169.254.2.2", Port: 3260,
+				ChapUsername: "ocid1.volume.oc1.chap", ChapSecret: "secret"},
+		},
+		{
+			name:           "missing IQN",
+			publishContext: map[string]string{disk.ISCSIIP: "169.254.2.2", disk.ISCSIPORT: "3260"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExtractISCSIInformation(tt.publishContext)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExtractISCSIInformation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractISCSIInformation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_Ip_Util_Methods(t *testing.T) {
 
 	tests := []struct {
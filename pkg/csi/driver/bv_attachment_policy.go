@@ -0,0 +1,255 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	cmdexec "os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+const (
+	// attachmentTypeAuto chooses the attachment type supported by the node
+	attachmentTypeAuto = "auto"
+	// attachmentSupportAnnotation is the node annotation the node driver
+	// records the attachment types and features supported by the node in, as
+	// a comma separated list of iscsi, multipath and paravirtualized
+	attachmentSupportAnnotation = "oci.oraclecloud.com/block-volume-attachment-support"
+	// attachmentTypeAnnotation is the node annotation with the preferred
+	// attachment type of the volumes of storage classes without an attachment
+	// type or with the auto attachment type
+	attachmentTypeAnnotation = "oci.oraclecloud.com/block-volume-attachment-type"
+	// attachmentSupportMultipath is the multipath feature of the node
+	attachmentSupportMultipath = "multipath"
+)
+
+var (
+	// virtioDevicesDir is the directory of the virtio devices of the node,
+	// which only virtual machines have
+	virtioDevicesDir = "/sys/bus/virtio/devices"
+	// isServiceActive returns whether the systemd unit of the node is active
+	isServiceActive = func(unit string) bool {
+		return cmdexec.Command(disk.CHROOT_BASH_COMMAND, "systemctl is-active --quiet "+unit).Run() == nil
+	}
+)
+
+// nodeAttachmentSupport are the attachment types and features supported by a
+// node.
+type nodeAttachmentSupport struct {
+	// iscsi is whether iscsid runs on the node
+	iscsi bool
+	// multipath is whether multipathd runs on the node
+	multipath bool
+	// paravirtualized is whether the node is a virtual machine, which
+	// paravirtualized volumes can be attached to
+	paravirtualized bool
+}
+
+func (s nodeAttachmentSupport) String() string {
+	var supported []string
+	if s.iscsi {
+		supported = append(supported, attachmentTypeISCSI)
+	}
+	if s.multipath {
+		supported = append(supported, attachmentSupportMultipath)
+	}
+	if s.paravirtualized {
+		supported = append(supported, attachmentTypeParavirtualized)
+	}
+	return strings.Join(supported, ",")
+}
+
+// parseNodeAttachmentSupport parses the value of the attachment support
+// annotation of a node.
+func parseNodeAttachmentSupport(value string) nodeAttachmentSupport {
+	var s nodeAttachmentSupport
+	for _, supported := range strings.Split(value, ",") {
+		switch strings.TrimSpace(supported) {
+		case attachmentTypeISCSI:
+			s.iscsi = true
+		case attachmentSupportMultipath:
+			s.multipath = true
+		case attachmentTypeParavirtualized:
+			s.paravirtualized = true
+		}
+	}
+	return s
+}
+
+// detectNodeAttachmentSupport returns the attachment types and features
+// supported by the node the node driver runs on.
+func detectNodeAttachmentSupport() nodeAttachmentSupport {
+	s := nodeAttachmentSupport{
+		// iscsid may be started on demand by its socket
+		iscsi:     isServiceActive("iscsid.service") || isServiceActive("iscsid.socket"),
+		multipath: isServiceActive("multipathd.service"),
+	}
+	if entries, err := os.ReadDir(virtioDevicesDir); err == nil && len(entries) > 0 {
+		s.paravirtualized = true
+	}
+	return s
+}
+
+// annotateNodeAttachmentSupport records the attachment types and features
+// supported by the node in its attachment support annotation, retrying until
+// the annotation is updated or the stop channel is closed.
+func (d BlockVolumeNodeDriver) annotateNodeAttachmentSupport(stopCh <-chan struct{}) {
+	if d.KubeClient == nil {
+		return
+	}
+	support := detectNodeAttachmentSupport()
+	logger := d.logger.With("nodeId", d.nodeID, "attachmentSupport", support.String())
+	logger.Info("Detected the block volume attachment support of the node.")
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{attachmentSupportAnnotation: support.String()},
+		},
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to build the attachment support annotation of the node.")
+		return
+	}
+	_ = wait.PollImmediateUntil(time.Minute, func() (bool, error) {
+		if _, err := d.KubeClient.CoreV1().Nodes().Patch(context.Background(), d.nodeID, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to annotate the node with its block volume attachment support, retrying.")
+			return false, nil
+		}
+		logger.Info("Annotated the node with its block volume attachment support.")
+		return true, nil
+	}, stopCh)
+}
+
+// attachmentPolicy chooses the attachment types of a volume on a node.
+type attachmentPolicy struct {
+	// attachmentType is the attachment type of the volume: iscsi,
+	// paravirtualized, auto or empty
+	attachmentType string
+	// nodeAttachmentType is the preferred attachment type of the node
+	nodeAttachmentType string
+	// support is the attachment support of the node, nil if it is unknown
+	support *nodeAttachmentSupport
+	// bootVolume is whether the volume is a boot volume, which can not be
+	// attached as paravirtualized volume
+	bootVolume bool
+	// useChap is whether the attachment uses CHAP, which requires iSCSI
+	useChap bool
+	// multipath is whether the volume is an ultra high performance volume,
+	// whose iSCSI attachments are multipath-enabled
+	multipath bool
+}
+
+// nodeAttachmentPolicy returns the attachment policy of the volume on the
+// node, from the annotations of the node. The annotations are ignored if the
+// node can not be read.
+func nodeAttachmentPolicy(log *zap.SugaredLogger, node *v1.Node, attachType string) attachmentPolicy {
+	policy := attachmentPolicy{attachmentType: attachType}
+	if node == nil {
+		return policy
+	}
+	if v, ok := node.Annotations[attachmentTypeAnnotation]; ok {
+		switch nodeAttachmentType := strings.ToLower(v); nodeAttachmentType {
+		case attachmentTypeISCSI, attachmentTypeParavirtualized, attachmentTypeAuto:
+			policy.nodeAttachmentType = nodeAttachmentType
+		default:
+			log.Warnf("Ignoring invalid %s annotation %q of the node.", attachmentTypeAnnotation, v)
+		}
+	}
+	if v, ok := node.Annotations[attachmentSupportAnnotation]; ok {
+		support := parseNodeAttachmentSupport(v)
+		policy.support = &support
+	}
+	return policy
+}
+
+// attachmentTypes returns the attachment types to attach the volume with, in
+// order of preference. The first one is the preferred attachment type, and
+// the next ones are used when attaching the volume with the previous one
+// fails. Volumes of the iscsi or paravirtualized attachment type are only
+// attached with their attachment type.
+func (p attachmentPolicy) attachmentTypes(enableInTransitEncryption bool) ([]string, error) {
+	if p.attachmentType == attachmentTypeISCSI || p.attachmentType == attachmentTypeParavirtualized {
+		return []string{p.attachmentType}, nil
+	}
+	preferred := p.attachmentType
+	if (preferred == "" || preferred == attachmentTypeAuto) && p.nodeAttachmentType != "" {
+		preferred = p.nodeAttachmentType
+	}
+	switch preferred {
+	case "":
+		preferred = attachmentTypeISCSI
+	case attachmentTypeAuto:
+		// the iSCSI attachments of ultra high performance volumes are multipath-enabled
+		preferred = attachmentTypeParavirtualized
+		if p.multipath && (p.support == nil || p.support.multipath) {
+			preferred = attachmentTypeISCSI
+		}
+	}
+
+	candidates := []string{attachmentTypeISCSI, attachmentTypeParavirtualized}
+	if preferred == attachmentTypeParavirtualized {
+		candidates = []string{attachmentTypeParavirtualized, attachmentTypeISCSI}
+	}
+	var attachmentTypes []string
+	for _, attachType := range candidates {
+		if attachType == attachmentTypeISCSI && (enableInTransitEncryption || (p.support != nil && !p.support.iscsi)) {
+			continue
+		}
+		if attachType == attachmentTypeParavirtualized && (p.bootVolume || p.useChap || (p.support != nil && !p.support.paravirtualized)) {
+			continue
+		}
+		attachmentTypes = append(attachmentTypes, attachType)
+	}
+	if len(attachmentTypes) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "no attachment type supported by the node can attach the volume "+
+			"(preferred attachment type: %s, node attachment support: %s, in-transit encryption: %t, %s: %t)",
+			preferred, p.supportString(), enableInTransitEncryption, useChapKey, p.useChap)
+	}
+	return attachmentTypes, nil
+}
+
+func (p attachmentPolicy) supportString() string {
+	if p.support == nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%q", p.support.String())
+}
+
+// isAttachmentTypeFallbackError returns whether the volume should be attached
+// with the next attachment type after attaching it failed with the error. It
+// is not the case for rate limiting and retryable errors, which would fail
+// the same way with any attachment type.
+func isAttachmentTypeFallbackError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || client.IsRetryable(err) {
+		return false
+	}
+	return !strings.Contains(errors.Cause(err).Error(), "rate limited")
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+func TestParseNodeAttachmentSupport(t *testing.T) {
+	tests := map[string]nodeAttachmentSupport{
+		"":                                {},
+		"iscsi":                           {iscsi: true},
+		"iscsi,multipath,paravirtualized": {iscsi: true, multipath: true, paravirtualized: true},
+		" paravirtualized , unknown":      {paravirtualized: true},
+	}
+	for value, want := range tests {
+		got := parseNodeAttachmentSupport(value)
+		if got != want {
+			t.Errorf("parseNodeAttachmentSupport(%q) = %+v, want %+v", value, got, want)
+		}
+		if parsed := parseNodeAttachmentSupport(got.String()); parsed != got {
+			t.Errorf("parseNodeAttachmentSupport(%q) = %+v, want %+v", got.String(), parsed, got)
+		}
+	}
+}
+
+func TestNodeAttachmentPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		node        *v1.Node
+		attachType  string
+		want        attachmentPolicy
+		wantSupport *nodeAttachmentSupport
+	}{
+		{
+			name:       "unknown node",
+			attachType: attachmentTypeISCSI,
+			want:       attachmentPolicy{attachmentType: attachmentTypeISCSI},
+		},
+		{
+			name:       "node without annotations",
+			node:       &v1.Node{},
+			attachType: attachmentTypeAuto,
+			want:       attachmentPolicy{attachmentType: attachmentTypeAuto},
+		},
+		{
+			name: "node with annotations",
+			node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				attachmentTypeAnnotation:    "Paravirtualized",
+				attachmentSupportAnnotation: "iscsi,paravirtualized",
+			}}},
+			want:        attachmentPolicy{nodeAttachmentType: attachmentTypeParavirtualized},
+			wantSupport: &nodeAttachmentSupport{iscsi: true, paravirtualized: true},
+		},
+		{
+			name: "node with invalid attachment type annotation",
+			node: &v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				attachmentTypeAnnotation: "nvme",
+			}}},
+			want: attachmentPolicy{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nodeAttachmentPolicy(zap.S(), tt.node, tt.attachType)
+			if !reflect.DeepEqual(got.support, tt.wantSupport) {
+				t.Errorf("nodeAttachmentPolicy() support = %+v, want %+v", got.support, tt.wantSupport)
+			}
+			got.support = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nodeAttachmentPolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttachmentPolicy_attachmentTypes(t *testing.T) {
+	all := &nodeAttachmentSupport{iscsi: true, multipath: true, paravirtualized: true}
+	noISCSI := &nodeAttachmentSupport{paravirtualized: true}
+	baremetal := &nodeAttachmentSupport{iscsi: true, multipath: true}
+	noMultipath := &nodeAttachmentSupport{iscsi: true, paravirtualized: true}
+
+	tests := []struct {
+		name                      string
+		policy                    attachmentPolicy
+		enableInTransitEncryption bool
+		want                      []string
+		wantErr                   bool
+	}{
+		{
+			name:   "default attachment type is iscsi",
+			policy: attachmentPolicy{},
+			want:   []string{attachmentTypeISCSI, attachmentTypeParavirtualized},
+		},
+		{
+			name:   "paravirtualized storage class",
+			policy: attachmentPolicy{attachmentType: attachmentTypeParavirtualized, support: all},
+			want:   []string{attachmentTypeParavirtualized},
+		},
+		{
+			name:   "auto prefers paravirtualized",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto},
+			want:   []string{attachmentTypeParavirtualized, attachmentTypeISCSI},
+		},
+		{
+			name:   "auto prefers multipath-enabled iscsi for ultra high performance volumes",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, multipath: true, support: all},
+			want:   []string{attachmentTypeISCSI, attachmentTypeParavirtualized},
+		},
+		{
+			name:   "auto prefers paravirtualized for ultra high performance volumes without multipathd",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, multipath: true, support: noMultipath},
+			want:   []string{attachmentTypeParavirtualized, attachmentTypeISCSI},
+		},
+		{
+			name:   "node attachment type overrides auto",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, nodeAttachmentType: attachmentTypeISCSI},
+			want:   []string{attachmentTypeISCSI, attachmentTypeParavirtualized},
+		},
+		{
+			name:   "node attachment type does not override the storage class",
+			policy: attachmentPolicy{attachmentType: attachmentTypeISCSI, nodeAttachmentType: attachmentTypeParavirtualized},
+			want:   []string{attachmentTypeISCSI},
+		},
+		{
+			name:   "default attachment type without iscsid falls back to paravirtualized",
+			policy: attachmentPolicy{support: noISCSI},
+			want:   []string{attachmentTypeParavirtualized},
+		},
+		{
+			name:   "iscsi storage class without iscsid does not fall back",
+			policy: attachmentPolicy{attachmentType: attachmentTypeISCSI, support: noISCSI},
+			want:   []string{attachmentTypeISCSI},
+		},
+		{
+			name:   "auto on bare metal falls back to iscsi",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, support: baremetal},
+			want:   []string{attachmentTypeISCSI},
+		},
+		{
+			name:   "paravirtualized storage class on bare metal does not fall back",
+			policy: attachmentPolicy{attachmentType: attachmentTypeParavirtualized, support: baremetal},
+			want:   []string{attachmentTypeParavirtualized},
+		},
+		{
+			name:   "boot volumes are not attached as paravirtualized",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, bootVolume: true},
+			want:   []string{attachmentTypeISCSI},
+		},
+		{
+			name:   "CHAP requires iscsi",
+			policy: attachmentPolicy{attachmentType: attachmentTypeAuto, useChap: true},
+			want:   []string{attachmentTypeISCSI},
+		},
+		{
+			name:                      "in-transit encryption requires paravirtualized",
+			policy:                    attachmentPolicy{},
+			enableInTransitEncryption: true,
+			want:                      []string{attachmentTypeParavirtualized},
+		},
+		{
+			name:                      "iscsi storage class with in-transit encryption is not switched to paravirtualized",
+			policy:                    attachmentPolicy{attachmentType: attachmentTypeISCSI},
+			enableInTransitEncryption: true,
+			want:                      []string{attachmentTypeISCSI},
+		},
+		{
+			name:    "CHAP without iscsid",
+			policy:  attachmentPolicy{useChap: true, support: noISCSI},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.policy.attachmentTypes(tt.enableInTransitEncryption)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("attachmentTypes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("attachmentTypes() error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("attachmentTypes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAttachmentTypeFallbackError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{
+			name: "invalid attachment",
+			ctx:  context.Background(),
+			err:  errors.WithStack(mockServiceError{StatusCode: http.StatusBadRequest, Message: "paravirtualized attachments are not supported"}),
+			want: true,
+		},
+		{
+			name: "retryable error",
+			ctx:  context.Background(),
+			err:  errors.WithStack(context.DeadlineExceeded),
+		},
+		{
+			name: "rate limited",
+			ctx:  context.Background(),
+			err:  client.RateLimitError(false, ""),
+		},
+		{
+			name: "context canceled",
+			ctx:  canceled,
+			err:  errors.New("context canceled"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isAttachmentTypeFallbackError(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("isAttachmentTypeFallbackError() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeNodeDriver_annotateNodeAttachmentSupport(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "virtio0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer func(d string, f func(string) bool) { virtioDevicesDir, isServiceActive = d, f }(virtioDevicesDir, isServiceActive)
+	virtioDevicesDir = dir
+	isServiceActive = func(unit string) bool { return unit == "iscsid.socket" }
+
+	kubeClient := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}})
+	d := BlockVolumeNodeDriver{NodeDriver: NodeDriver{nodeID: "node1", KubeClient: kubeClient, logger: zap.S()}}
+	d.annotateNodeAttachmentSupport(make(chan struct{}))
+
+	node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), "node1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := node.Annotations[attachmentSupportAnnotation], "iscsi,paravirtualized"; got != want {
+		t.Errorf("attachment support annotation = %q, want %q", got, want)
+	}
+}
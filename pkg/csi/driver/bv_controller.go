@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -31,13 +33,17 @@ import (
 	"google.golang.org/grpc/status"
 	kubeAPI "k8s.io/api/core/v1"
 	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 )
 
@@ -69,16 +75,73 @@ const (
 	resourceTrackingFeatureFlagName = "CPO_ENABLE_RESOURCE_ATTRIBUTION"
 	OkeSystemTagNamesapce           = "orcl-containerengine"
 	MaxDefinedTagPerVolume          = 64
+	// detachedAutotuneKey enables the detached volume autotune policy
+	detachedAutotuneKey = "detachedAutotune"
+	// maxVpusPerGBKey enables the performance based autotune policy up to this performance level
+	maxVpusPerGBKey = "maxVpusPerGB"
+	// autoTunedVpusPerGBKey is the performance level the volume is autotuned to, in the volume context of ControllerGetVolume
+	autoTunedVpusPerGBKey = "autoTunedVpusPerGB"
+	// PVC annotations overriding the autotune policies of the storage class
+	detachedAutotuneAnnotation = "oci.oraclecloud.com/detached-autotune"
+	maxVpusPerGBAnnotation     = "oci.oraclecloud.com/max-vpus-per-gb"
+	// Persistent volume claim metadata passed by the csi-provisioner with --extra-create-metadata
+	pvcNameKey      = "csi.storage.k8s.io/pvc/name"
+	pvcNamespaceKey = "csi.storage.k8s.io/pvc/namespace"
+	// ultraHighPerformanceVpusPerGB is the lowest performance level of Ultra High Performance volumes
+	ultraHighPerformanceVpusPerGB = 30
+	// backupCopyRegions is the comma separated list of the regions the backups of the volume snapshot class are copied to
+	backupCopyRegions = "backupCopyRegions"
+	// backupSourceRegion is the region of the backups the volumes of the storage class are restored from
+	backupSourceRegion = "backupSourceRegion"
+	// backupCopiesAnnotation holds the OCIDs of the copies of the backup of a volume snapshot by region
+	backupCopiesAnnotation = "oci.oraclecloud.com/volume-backup-copies"
+	// Volume snapshot metadata passed by the csi-snapshotter with --extra-create-metadata
+	volumeSnapshotNameKey        = "csi.storage.k8s.io/volumesnapshot/name"
+	volumeSnapshotNamespaceKey   = "csi.storage.k8s.io/volumesnapshot/namespace"
+	volumeSnapshotContentNameKey = "csi.storage.k8s.io/volumesnapshotcontent/name"
+	// backupPolicyKey is the Oracle-defined (bronze, silver or gold) or custom volume backup policy assigned to the volumes
+	backupPolicyKey = "backupPolicy"
+	// backupRetentionKey decides whether the scheduled backups of the volumes are retained or deleted with the volumes
+	backupRetentionKey    = "backupRetention"
+	backupRetentionRetain = "retain"
+	backupRetentionDelete = "delete"
+	// backupRetentionTag is the freeform tag recording the backup retention of a volume for its deletion
+	backupRetentionTag = "oci-csi-backup-retention"
+	// backupPolicyIDPrefix is the prefix of the OCIDs of custom volume backup policies
+	backupPolicyIDPrefix = "ocid1.volumebackuppolicy."
+	// replicaAvailabilityDomainsKey is the comma separated list of the availability domains, of this or other regions, the volumes are replicated to
+	replicaAvailabilityDomainsKey = "replicaAvailabilityDomains"
+	// blockVolumeReplicasAnnotation holds the OCIDs and the sync status of the replicas of the volume of a persistent volume
+	blockVolumeReplicasAnnotation = "oci.oraclecloud.com/block-volume-replicas"
+	// blockVolumeReplicaIDPrefix is the prefix of the OCIDs of block volume replicas, which can be the snapshot handle of a volume snapshot content to promote
+	blockVolumeReplicaIDPrefix = "ocid1.blockvolumereplica."
+	// useChapKey enables CHAP authentication of the iSCSI sessions of the attachments of the volumes
+	useChapKey = "useChap"
+	// luksEncryptionKey enables the client-side LUKS encryption of the volumes by the node driver
+	luksEncryptionKey = "luksEncryption"
+	// luksKeySecretKey is the key of the LUKS encryption key in the node stage secret of the volumes
+	luksKeySecretKey = "luksKey"
+	// mkfsOptionsKey is the space separated mkfs options the node driver formats the volumes with
+	mkfsOptionsKey = "mkfsOptions"
+	// defaultMountOptionsKey is the comma separated mount options the node driver stages the volumes with, unless overridden by the mount options of the persistent volumes
+	defaultMountOptionsKey = "defaultMountOptions"
 )
 
 var (
 	// OCI Block Storage and Compute support shareable block volume attachments
 	// This driver supports Mounted (Filesystem) and Block (raw block device) AccessTypes (volumeMode in k8s)
 	// MULTI_WRITER AccessMode (RWX) can only be used with AccessType Block
+	// MULTI_NODE_READER_ONLY AccessMode (ROX) uses shareable read-only attachments
 	supportedVolumeCapabilities = []*csi.VolumeCapability{
 		{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}},
+		{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER}},
+		{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_MULTI_WRITER}},
+		{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY}},
 		{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER}},
+		{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER}},
+		{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_MULTI_WRITER}},
 		{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER}},
+		{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}, AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY}},
 	}
 
 	// OCI boot volumes only support attachment to a single node at a time
@@ -100,6 +163,26 @@ type VolumeParameters struct {
 	definedTags map[string]map[string]interface{}
 	//volume performance units per gb describes the block volume performance level
 	vpusPerGB int64
+	// detachedAutotune enables the detached volume autotune policy
+	detachedAutotune bool
+	// maxVpusPerGB enables the performance based autotune policy up to this performance level, 0 if disabled
+	maxVpusPerGB int64
+	// backupSourceRegion is the region of the volume backups to restore from, empty for the region of the cluster
+	backupSourceRegion string
+	// backupPolicy is the name of the Oracle-defined or the OCID of the custom backup policy, empty for none
+	backupPolicy string
+	// backupRetention is whether the scheduled backups are retained or deleted with the volume
+	backupRetention string
+	// replicaAvailabilityDomains are the full names of the availability domains to replicate the volume to
+	replicaAvailabilityDomains []string
+	// useChap enables CHAP authentication of the iSCSI attachments of the volume
+	useChap bool
+	// luksEncryption enables the client-side LUKS encryption of the volume
+	luksEncryption bool
+	// mkfsOptions are the space separated mkfs options of the filesystem of the volume
+	mkfsOptions string
+	// defaultMountOptions are the comma separated default mount options of the filesystem of the volume
+	defaultMountOptions string
 }
 
 // VolumeAttachmentOption holds config for attachments
@@ -114,6 +197,12 @@ type VolumeAttachmentOption struct {
 	enforceLimit bool
 	// number of attachments the volume is allowed
 	maxVolumeAttachments int
+	// whether the iSCSI sessions of the attachment are authenticated with CHAP
+	useChap bool
+	// whether the attachment is read-only
+	isReadOnly bool
+	// the attachment types to attach the volume with, in order of preference
+	attachmentTypes []string
 }
 
 type SnapshotParameters struct {
@@ -123,6 +212,8 @@ type SnapshotParameters struct {
 	freeformTags map[string]string
 	// defined tags to add for backups
 	definedTags map[string]map[string]interface{}
+	// regions to copy the backups to
+	copyRegions []string
 }
 
 func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]string) (VolumeParameters, error) {
@@ -141,9 +232,9 @@ func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]strin
 			}
 		case attachmentType:
 			attachmentTypeLower := strings.ToLower(v)
-			if attachmentTypeLower != attachmentTypeISCSI && attachmentTypeLower != attachmentTypeParavirtualized {
+			if attachmentTypeLower != attachmentTypeISCSI && attachmentTypeLower != attachmentTypeParavirtualized && attachmentTypeLower != attachmentTypeAuto {
 				return p, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid attachment-type: %s provided "+
-					"for storageclass. supported attachment-types are %s and %s", v, attachmentTypeISCSI, attachmentTypeParavirtualized))
+					"for storageclass. supported attachment-types are %s, %s and %s", v, attachmentTypeISCSI, attachmentTypeParavirtualized, attachmentTypeAuto))
 			}
 			p.attachmentParameter[attachmentType] = attachmentTypeLower
 
@@ -177,9 +268,70 @@ func extractVolumeParameters(log *zap.SugaredLogger, parameters map[string]strin
 				return p, status.Error(codes.InvalidArgument, err.Error())
 			}
 			p.vpusPerGB = vpusPerGB
+		case detachedAutotuneKey:
+			detachedAutotune, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass, it must be true or false", detachedAutotuneKey, v)
+			}
+			p.detachedAutotune = detachedAutotune
+		case maxVpusPerGBKey:
+			maxVpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
+			if err != nil {
+				return p, status.Error(codes.InvalidArgument, err.Error())
+			}
+			p.maxVpusPerGB = maxVpusPerGB
+		case backupSourceRegion:
+			p.backupSourceRegion = strings.TrimSpace(v)
+		case backupPolicyKey:
+			backupPolicy := strings.TrimSpace(v)
+			if !strings.HasPrefix(backupPolicy, backupPolicyIDPrefix) {
+				switch backupPolicy = strings.ToLower(backupPolicy); backupPolicy {
+				case "bronze", "silver", "gold":
+				default:
+					return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass, it must be "+
+						"bronze, silver, gold or the OCID of a volume backup policy", backupPolicyKey, v)
+				}
+			}
+			p.backupPolicy = backupPolicy
+		case backupRetentionKey:
+			backupRetention := strings.ToLower(strings.TrimSpace(v))
+			if backupRetention != backupRetentionRetain && backupRetention != backupRetentionDelete {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass, supported values are %s and %s",
+					backupRetentionKey, v, backupRetentionRetain, backupRetentionDelete)
+			}
+			p.backupRetention = backupRetention
+		case replicaAvailabilityDomainsKey:
+			for _, ad := range strings.Split(v, ",") {
+				// full availability domain names as in the topology labels are accepted
+				if ad = strings.ReplaceAll(strings.TrimSpace(ad), ".", ":"); ad != "" {
+					p.replicaAvailabilityDomains = append(p.replicaAvailabilityDomains, ad)
+				}
+			}
+		case useChapKey:
+			useChap, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass, it must be true or false", useChapKey, v)
+			}
+			p.useChap = useChap
+		case luksEncryptionKey:
+			luksEncryption, err := strconv.ParseBool(v)
+			if err != nil {
+				return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for storageclass, it must be true or false", luksEncryptionKey, v)
+			}
+			p.luksEncryption = luksEncryption
+		case mkfsOptionsKey:
+			p.mkfsOptions = strings.Join(strings.Fields(v), " ")
+		case defaultMountOptionsKey:
+			p.defaultMountOptions = v
 		}
 
 	}
+	if p.backupRetention != "" && p.backupPolicy == "" {
+		return p, status.Errorf(codes.InvalidArgument, "%s requires %s for storageclass", backupRetentionKey, backupPolicyKey)
+	}
+	if p.useChap && p.attachmentParameter[attachmentType] == attachmentTypeParavirtualized {
+		return p, status.Errorf(codes.InvalidArgument, "%s requires the %s attachment type for storageclass", useChapKey, attachmentTypeISCSI)
+	}
 	return p, nil
 }
 
@@ -221,6 +373,12 @@ func extractSnapshotParameters(parameters map[string]string) (SnapshotParameters
 					"in volumesnapshotclass. please check the parameters block on the volume snapshot class")
 			}
 			p.definedTags = definedTags
+		case backupCopyRegions:
+			for _, region := range strings.Split(v, ",") {
+				if region = strings.TrimSpace(region); region != "" {
+					p.copyRegions = append(p.copyRegions, region)
+				}
+			}
 		}
 	}
 	return p, nil
@@ -269,6 +427,26 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		return nil, status.Errorf(codes.InvalidArgument, "failed to parse storageclass parameters %v", err)
 	}
 
+	volumeParams, err = d.applyPVCAutotuneOverrides(ctx, log, req.GetParameters(), volumeParams)
+	if err == nil {
+		err = validateAutotunePolicies(volumeParams.vpusPerGB, volumeParams.detachedAutotune, volumeParams.maxVpusPerGB)
+	}
+	if err != nil {
+		log.With(zap.Error(err)).Error("Invalid autotune policies.")
+		metricDimension = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = metricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
+	if err := validateFilesystemOptions(log, req.VolumeCapabilities, &volumeParams); err != nil {
+		log.With(zap.Error(err)).Error("Invalid filesystem options.")
+		metricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = metricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
 	// Return error for the case of Raw Block Volume with Ultra High Performance Volumes
 	for _, cap := range req.VolumeCapabilities {
 		if blk := cap.GetBlock(); blk != nil && volumeParams.vpusPerGB >= 30 {
@@ -292,7 +470,27 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			return nil, status.Error(codes.InvalidArgument, "Unsupported volumeContentSource")
 		}
 
-		if isVolumeContentSource_Snapshot {
+		if isVolumeContentSource_Snapshot && strings.HasPrefix(volumeContentSource.GetSnapshot().GetSnapshotId(), blockVolumeReplicaIDPrefix) {
+			// Promote the replica of a volume of another availability domain or region
+			id := volumeContentSource.GetSnapshot().GetSnapshotId()
+			replica, err := d.promotableBlockVolumeReplica(ctx, log, id)
+			if err != nil {
+				return nil, err
+			}
+
+			availableDomainShortName = *replica.AvailabilityDomain
+			log.With("AD", availableDomainShortName).Info("Using availability domain of block volume replica to provision promoted volume.")
+
+			if replicaSize := *replica.SizeInGBs * client.GiB; replicaSize < size {
+				volumeContext[needResize] = "true"
+				volumeContext[newSize] = strconv.FormatInt(size, 10)
+			}
+
+			srcSnapshotId = id
+			if client.IsIpv6SingleStackCluster() {
+				fullAvailabilityDomainName = availableDomainShortName
+			}
+		} else if isVolumeContentSource_Snapshot {
 			srcSnapshot := volumeContentSource.GetSnapshot()
 			if srcSnapshot == nil {
 				log.With("volumeSourceType", "snapshot").Error("Error fetching snapshot from the volumeContentSource")
@@ -300,14 +498,23 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			}
 
 			id := srcSnapshot.GetSnapshotId()
-			volumeBackup, err := d.client.BlockStorage().GetVolumeBackup(ctx, id)
-			if err != nil {
-				if k8sapierrors.IsNotFound(err) {
-					log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).Errorf("Failed to get snapshot with ID %v", id)
-					return nil, status.Errorf(codes.NotFound, "Failed to get snapshot with ID %v", id)
+			var volumeBackup *core.VolumeBackup
+			if volumeParams.backupSourceRegion != "" {
+				volumeBackup, err = d.volumeBackupCopy(ctx, log, id, volumeParams.backupSourceRegion)
+				if err != nil {
+					return nil, err
+				}
+				id = *volumeBackup.Id
+			} else {
+				volumeBackup, err = d.client.BlockStorage().GetVolumeBackup(ctx, id)
+				if err != nil {
+					if k8sapierrors.IsNotFound(err) {
+						log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).Errorf("Failed to get snapshot with ID %v", id)
+						return nil, status.Errorf(codes.NotFound, "Failed to get snapshot with ID %v", id)
+					}
+					log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).Errorf("Failed to fetch snapshot with ID %v with error %v", id, err)
+					return nil, status.Errorf(codes.Internal, "Failed to fetch snapshot with ID %v with error %v", id, err)
 				}
-				log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).Errorf("Failed to fetch snapshot with ID %v with error %v", id, err)
-				return nil, status.Errorf(codes.Internal, "Failed to fetch snapshot with ID %v with error %v", id, err)
 			}
 
 			volumeBackupSize := *volumeBackup.SizeInMBs * client.MiB
@@ -440,6 +647,20 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		return nil, fmt.Errorf("duplicate volume %q exists", volumeName)
 	}
 
+	backupPolicyID := ""
+	if volumeParams.backupPolicy != "" {
+		backupPolicyID, err = getVolumeBackupPolicyID(ctx, d.client, volumeParams.backupPolicy)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackupPolicy", "statusCode", util.GetHttpStatusCode(err)).
+				With("backupPolicy", volumeParams.backupPolicy).With(zap.Error(err)).Error("Failed to get volume backup policy.")
+			errorType = util.GetError(err)
+			metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+			dimensionsMap[metrics.ComponentDimension] = metricDimension
+			metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to get volume backup policy %s: %v", volumeParams.backupPolicy, err)
+		}
+	}
+
 	provisionedVolume := core.Volume{}
 
 	if len(volumes) > 0 {
@@ -448,6 +669,16 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 		//Assigning existing volume
 		provisionedVolume = volumes[0]
 
+		if backupPolicyID != "" {
+			if err = assignVolumeBackupPolicy(ctx, log, d.client, *provisionedVolume.Id, backupPolicyID); err != nil {
+				errorType = util.GetError(err)
+				metricDimension = util.GetMetricDimensionForComponent(errorType, metricType)
+				dimensionsMap[metrics.ComponentDimension] = metricDimension
+				metrics.SendMetricData(d.metricPusher, metric, time.Since(startTime).Seconds(), dimensionsMap)
+				return nil, status.Errorf(codes.Internal, "failed to assign volume backup policy %s: %v", volumeParams.backupPolicy, err)
+			}
+		}
+
 	} else {
 		// Creating new volume
 		if !client.IsIpv6SingleStackCluster() {
@@ -467,9 +698,14 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 
 
 		bvTags := getBVTags(log, d.config.Tags, volumeParams)
+		if backupPolicyID != "" {
+			bvTags = withBackupRetentionTag(bvTags, volumeParams.backupRetention)
+		}
 
 		provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, fullAvailabilityDomainName, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-			volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+			volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags, backupPolicyID,
+			autotunePolicies(volumeParams.detachedAutotune, volumeParams.maxVpusPerGB),
+			blockVolumeReplicas(volumeName, volumeParams.replicaAvailabilityDomains))
 
 		if err != nil && client.IsSystemTagNotFoundOrNotAuthorisedError(log, errors.Unwrap(err)) {
 			log.With("Ad name", fullAvailabilityDomainName, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Warn("New volume creation failed due to oke system tags error. sending metric & retrying without oke system tags")
@@ -481,7 +717,9 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 			// retry provision without oke system tags
 			delete(bvTags.DefinedTags, OkeSystemTagNamesapce)
 			provisionedVolume, err = provision(ctx, log, d.client, volumeName, size, fullAvailabilityDomainName, d.config.CompartmentID, srcSnapshotId, srcVolumeId,
-				volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags)
+				volumeParams.diskEncryptionKey, volumeParams.vpusPerGB, bvTags, backupPolicyID,
+				autotunePolicies(volumeParams.detachedAutotune, volumeParams.maxVpusPerGB),
+				blockVolumeReplicas(volumeName, volumeParams.replicaAvailabilityDomains))
 		}
 		if err != nil {
 			log.With("Ad name", fullAvailabilityDomainName, "Compartment Id", d.config.CompartmentID).With(zap.Error(err)).Error("New volume creation failed.")
@@ -521,6 +759,27 @@ func (d *BlockVolumeControllerDriver) CreateVolume(ctx context.Context, req *csi
 
 	volumeContext[attachmentType] = volumeParams.attachmentParameter[attachmentType]
 	volumeContext[csi_util.VpusPerGB] = strconv.FormatInt(volumeParams.vpusPerGB, 10)
+	if volumeParams.detachedAutotune {
+		volumeContext[detachedAutotuneKey] = "true"
+	}
+	if volumeParams.maxVpusPerGB != 0 {
+		volumeContext[maxVpusPerGBKey] = strconv.FormatInt(volumeParams.maxVpusPerGB, 10)
+	}
+	if len(volumeParams.replicaAvailabilityDomains) > 0 {
+		volumeContext[replicaAvailabilityDomainsKey] = strings.Join(volumeParams.replicaAvailabilityDomains, ",")
+	}
+	if volumeParams.useChap {
+		volumeContext[useChapKey] = "true"
+	}
+	if volumeParams.luksEncryption {
+		volumeContext[luksEncryptionKey] = "true"
+	}
+	if volumeParams.mkfsOptions != "" {
+		volumeContext[mkfsOptionsKey] = volumeParams.mkfsOptions
+	}
+	if volumeParams.defaultMountOptions != "" {
+		volumeContext[defaultMountOptionsKey] = volumeParams.defaultMountOptions
+	}
 
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
@@ -562,6 +821,25 @@ func (d *BlockVolumeControllerDriver) DeleteVolume(ctx context.Context, req *csi
 		return nil, status.Error(codes.InvalidArgument, "DeleteVolume Volume ID must be provided")
 	}
 
+	if !client.IsBootVolume(req.VolumeId) {
+		if err := d.cleanupVolumeBackupPolicy(ctx, log, req.VolumeId); err != nil {
+			log.With(zap.Error(err)).Error("Failed to clean up the volume backup policy of the volume.")
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to clean up the volume backup policy of volume %s: %v", req.VolumeId, err)
+		}
+		if err := d.disableVolumeReplication(ctx, log, req.VolumeId); err != nil {
+			log.With(zap.Error(err)).Error("Failed to tear down the replicas of the volume.")
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVDelete, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.Internal, "failed to tear down the replicas of volume %s: %v", req.VolumeId, err)
+		}
+	}
+
 	log.Info("Deleting Volume")
 	err := d.client.BlockStorage().DeleteVolume(ctx, req.VolumeId)
 	if err != nil {
@@ -608,12 +886,18 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 
 	// if the access mode is MULTI_NODE, set isShareable to true
 	isShareable := false
+	// if the access mode is MULTI_NODE_READER_ONLY, set isReadOnly to true
+	isReadOnly := false
 	if req.VolumeCapability.AccessMode != nil {
 		mode := req.VolumeCapability.AccessMode.Mode
 		if mode == csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER {
 			isShareable = true
 			csiMetricPrefix = metrics.PVAttachRWX
 		}
+		if mode == csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY {
+			isShareable = true
+			isReadOnly = true
+		}
 	}
 
 	log := d.logger.With("volumeID", req.VolumeId, "nodeId", req.NodeId, "csiOperation", "attach")
@@ -630,11 +914,8 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 	id = client.MapProviderIDToInstanceID(id)
 	dimensionsMap[metrics.InstanceIdDimension] = id
 
-	// if the attachmentType is missing, default is iscsi
-	attachType, ok := req.VolumeContext[attachmentType]
-	if !ok {
-		attachType = attachmentTypeISCSI
-	}
+	// if the attachmentType is missing, it is chosen by the attachment policy of the node, and defaults to iscsi
+	attachType := req.VolumeContext[attachmentType]
 
 	vpusPerGB, ok := req.VolumeContext[csi_util.VpusPerGB]
 	if !ok || vpusPerGB == "" {
@@ -667,8 +948,35 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		}
 	}
 
-	volumeAttachmentOptions, err := getAttachmentOptions(ctx, d.client.Compute(), attachType, id, isShareable)
+	useChap := false
+	if v, ok := req.VolumeContext[useChapKey]; ok {
+		if useChap, err = strconv.ParseBool(v); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %s in volume context, it must be true or false", useChapKey, v)
+		}
+	}
+
+	node, err := d.KubeClient.CoreV1().Nodes().Get(ctx, req.NodeId, metav1.GetOptions{})
 	if err != nil {
+		log.With(zap.Error(err)).Warn("Failed to get the node, ignoring its attachment policy.")
+		node = nil
+	}
+	policy := nodeAttachmentPolicy(log, node, attachType)
+	policy.bootVolume = bootVolume
+	policy.useChap = useChap
+	if vpusValue, err := strconv.Atoi(vpusPerGB); err == nil && vpusValue >= 30 {
+		policy.multipath = true
+	}
+
+	volumeAttachmentOptions, err := getAttachmentOptions(ctx, d.client.Compute(), policy, id, isShareable)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			// none of the attachment types supported by the node can attach the volume
+			log.With(zap.Error(err)).With("attachmentType", attachType, "instanceID", id).Error("No attachment type can attach the volume to the node.")
+			csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
 		log.With("service", "compute", "verb", "get", "resource", "instance", "statusCode", util.GetHttpStatusCode(err)).
 			With(zap.Error(err)).With("attachmentType", attachType, "instanceID", id).Error("failed to get the attachment options")
 		errorType = util.GetError(err)
@@ -677,6 +985,11 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
 		return nil, status.Errorf(codes.Unknown, "failed to get the attachment options. error : %s", err)
 	}
+	volumeAttachmentOptions.useChap = useChap
+	if volumeAttachmentOptions.useChap && volumeAttachmentOptions.useParavirtualizedAttachment {
+		return nil, status.Errorf(codes.InvalidArgument, "%s requires the %s attachment type", useChapKey, attachmentTypeISCSI)
+	}
+	volumeAttachmentOptions.isReadOnly = isReadOnly
 
 	//in transit encryption is not supported for other attachment type than paravirtualized
 	if volumeAttachmentOptions.enableInTransitEncryption && !volumeAttachmentOptions.useParavirtualizedAttachment {
@@ -686,6 +999,7 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
 		return nil, status.Errorf(codes.InvalidArgument, "node %s has in transit encryption enabled, but attachment type is not paravirtualized. invalid input", id)
 	}
+	log = log.With("attachmentTypes", volumeAttachmentOptions.attachmentTypes)
 
 	compartmentID, err := util.LookupNodeCompartment(d.KubeClient, req.NodeId)
 	if err != nil {
@@ -768,6 +1082,21 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 				//Checking if Volume state is already Attached or Attachment (from above condition) is completed
 				if nodeVolumeAttachment.GetLifecycleState() == core.VolumeAttachmentLifecycleStateAttached {
 					log.With("instanceID", id).Info("Volume is already ATTACHED to the Node.")
+					if iSCSIAttachment, ok := nodeVolumeAttachment.(core.IScsiVolumeAttachment); ok && volumeAttachmentOptions.useChap && iSCSIAttachment.ChapSecret == nil {
+						// get the attachment when its CHAP credentials are missing from the list of attachments
+						nodeVolumeAttachment, err = d.client.Compute().WaitForVolumeAttached(ctx, *nodeVolumeAttachment.GetId())
+						if err != nil {
+							log.With("service", "compute", "verb", "get", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
+								With("instanceID", id).With(zap.Error(err)).Error("Failed to get the CHAP credentials of the volume attachment.")
+							errorType = util.GetError(err)
+							csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+							dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+							metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+							return nil, status.Errorf(codes.Internal, "Failed to get the CHAP credentials of the volume attachment: %s", err)
+						}
+					}
+					// the volume may have been attached with another attachment type than the preferred one
+					_, volumeAttachmentOptions.useParavirtualizedAttachment = nodeVolumeAttachment.(core.ParavirtualizedVolumeAttachment)
 					resp, err := generatePublishContext(volumeAttachmentOptions, log, nodeVolumeAttachment, vpusPerGB, req.VolumeContext[needResize], req.VolumeContext[newSize])
 					if err != nil {
 						log.With(zap.Error(err)).Error("Failed to generate publish context")
@@ -777,6 +1106,8 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 						metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
 						return nil, status.Errorf(codes.Internal, "Failed to generate publish context: %s", err)
 					}
+					d.addPerformanceTier(ctx, log, req.VolumeId, resp.PublishContext)
+					tracing.InjectPublishContext(ctx, resp.PublishContext)
 					return resp, nil
 				}
 			}
@@ -795,34 +1126,46 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 					return nil, status.Errorf(codes.Internal, "Failed to attach volume to node. "+
 						"The volume already has a non-shareable attachment.")
 				}
+				// read-only attachments can not share the volume with read/write attachments
+				if volumeAttachmentOptions.isReadOnly != isReadOnlyAttachment(attachment) {
+					log.Errorf("Volume attachment (ID: %s) of instance (ID: %s) does not match the requested read-only mode", *attachment.GetId(), *attachment.GetInstanceId())
+					csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+					dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+					metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+					return nil, status.Errorf(codes.FailedPrecondition, "Failed to attach volume to node. "+
+						"The volume already has attachments which are not read-only, or read-only attachments only.")
+				}
 			}
 		}
 	}
 
-	log.Info("Attaching volume to instance")
+	for i, attachType := range volumeAttachmentOptions.attachmentTypes {
+		volumeAttachmentOptions.useParavirtualizedAttachment = attachType == attachmentTypeParavirtualized
+		log.With("attachmentType", attachType).Info("Attaching volume to instance")
 
-	if volumeAttachmentOptions.useParavirtualizedAttachment {
-		nodeVolumeAttachment, err = d.client.Compute().AttachParavirtualizedVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.enableInTransitEncryption, volumeAttachmentOptions.isShareable)
-		if err != nil {
-			log.With("service", "compute", "verb", "create", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
-				With("instanceID", id).With(zap.Error(err)).Info("failed paravirtualized attachment instance to volume.")
-			errorType = util.GetError(err)
-			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
-			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
-			metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
-			return nil, status.Errorf(codes.Internal, "failed paravirtualized attachment instance to volume. error : %s", err)
+		if volumeAttachmentOptions.useParavirtualizedAttachment {
+			nodeVolumeAttachment, err = d.client.Compute().AttachParavirtualizedVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.enableInTransitEncryption, volumeAttachmentOptions.isShareable, volumeAttachmentOptions.isReadOnly)
+		} else {
+			nodeVolumeAttachment, err = d.client.Compute().AttachVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.isShareable, volumeAttachmentOptions.isReadOnly, volumeAttachmentOptions.useChap)
 		}
-	} else {
-		nodeVolumeAttachment, err = d.client.Compute().AttachVolume(ctx, id, req.VolumeId, volumeAttachmentOptions.isShareable)
-		if err != nil {
+		if err == nil {
+			break
+		}
+		if i+1 < len(volumeAttachmentOptions.attachmentTypes) && isAttachmentTypeFallbackError(ctx, err) {
 			log.With("service", "compute", "verb", "create", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
-				With("instanceID", id).With(zap.Error(err)).Info("failed iscsi attachment instance to volume.")
-			errorType = util.GetError(err)
-			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
-			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
-			metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
-			return nil, status.Errorf(codes.Internal, "failed iscsi attachment instance to volume : %s", err)
+				With("instanceID", id).With(zap.Error(err)).Warnf("failed %s attachment instance to volume, falling back to %s attachment.", attachType, volumeAttachmentOptions.attachmentTypes[i+1])
+			continue
+		}
+		log.With("service", "compute", "verb", "create", "resource", "volumeAttachment", "statusCode", util.GetHttpStatusCode(err)).
+			With("instanceID", id).With(zap.Error(err)).Infof("failed %s attachment instance to volume.", attachType)
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
+		if volumeAttachmentOptions.useParavirtualizedAttachment {
+			return nil, status.Errorf(codes.Internal, "failed paravirtualized attachment instance to volume. error : %s", err)
 		}
+		return nil, status.Errorf(codes.Internal, "failed iscsi attachment instance to volume : %s", err)
 	}
 
 	nodeVolumeAttachment, err = d.client.Compute().WaitForVolumeAttached(ctx, *nodeVolumeAttachment.GetId())
@@ -848,6 +1191,8 @@ func (d *BlockVolumeControllerDriver) ControllerPublishVolume(ctx context.Contex
 		metrics.SendMetricData(d.metricPusher, csiMetricPrefix, time.Since(startTime).Seconds(), dimensionsMap)
 		return nil, status.Errorf(codes.Internal, "Failed to generate publish context: %s", err)
 	}
+	d.addPerformanceTier(ctx, log, req.VolumeId, resp.PublishContext)
+	tracing.InjectPublishContext(ctx, resp.PublishContext)
 	return resp, nil
 }
 
@@ -899,6 +1244,14 @@ func generatePublishContext(volumeAttachmentOptions VolumeAttachmentOption, log
 		publishContext["device"] = *dev
 	}
 
+	if volumeAttachmentOptions.useChap {
+		if iSCSIVolumeAttached.ChapUsername == nil || iSCSIVolumeAttached.ChapSecret == nil {
+			return nil, errors.Errorf("volume attachment %s has no CHAP credentials", *volumeAttached.GetId())
+		}
+		publishContext[disk.ISCSICHAPUSERNAME] = *iSCSIVolumeAttached.ChapUsername
+		publishContext[disk.ISCSICHAPSECRET] = *iSCSIVolumeAttached.ChapSecret
+	}
+
 	return &csi.ControllerPublishVolumeResponse{
 		PublishContext: publishContext,
 	}, nil
@@ -1075,14 +1428,163 @@ func (d *BlockVolumeControllerDriver) ValidateVolumeCapabilities(ctx context.Con
 	}, nil
 }
 
-// ListVolumes returns a list of all requested volumes
+// listVolumesEnabled returns true if the ListVolumes RPC is enabled in the
+// block volume configuration.
+func (d *BlockVolumeControllerDriver) listVolumesEnabled() bool {
+	return d.config != nil && d.config.BlockVolume != nil && d.config.BlockVolume.ListVolumes
+}
+
+// ListVolumes returns a page of the volumes of the cluster, identified by the
+// configured freeform tags or volume name prefix, with the nodes they are
+// published to.
 func (d *BlockVolumeControllerDriver) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	if !d.listVolumesEnabled() {
+		return nil, status.Error(codes.Unimplemented, "")
+	}
+	if req.MaxEntries < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max entries %d", req.MaxEntries)
+	}
+	log := d.logger.With("compartmentID", d.config.CompartmentID, "startingToken", req.StartingToken)
+
+	volumes, nextPage, err := d.client.BlockStorage().ListVolumes(ctx, d.config.CompartmentID, int(req.MaxEntries), req.StartingToken)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list volumes.")
+		return nil, listPageError(err, req.StartingToken, "volumes")
+	}
+
+	publishedNodeIDs, err := d.publishedNodeIDs(ctx)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list volume attachments.")
+		return nil, status.Errorf(codes.Internal, "failed to list volume attachments: %v", err)
+	}
+
+	entries := make([]*csi.ListVolumesResponse_Entry, 0, len(volumes))
+	for _, volume := range volumes {
+		if volume.Id == nil || !d.isClusterVolume(volume) {
+			continue
+		}
+		entry := &csi.ListVolumesResponse_Entry{
+			Volume: &csi.Volume{
+				VolumeId: *volume.Id,
+			},
+			Status: &csi.ListVolumesResponse_VolumeStatus{
+				PublishedNodeIds: publishedNodeIDs[*volume.Id],
+			},
+		}
+		if volume.SizeInMBs != nil {
+			entry.Volume.CapacityBytes = *volume.SizeInMBs * client.MiB
+		}
+		if volume.AvailabilityDomain != nil {
+			ad := d.util.GetAvailableDomainInNodeLabel(*volume.AvailabilityDomain)
+			entry.Volume.AccessibleTopology = []*csi.Topology{
+				{
+					Segments: map[string]string{kubeAPI.LabelTopologyZone: ad},
+				},
+				{
+					Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: ad},
+				},
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return &csi.ListVolumesResponse{
+		Entries:   entries,
+		NextToken: nextPage,
+	}, nil
+}
+
+// listPageError returns the gRPC status of the error of a paginated OCI list
+// call. An OCI bad request for a starting token means that the token is
+// invalid, which aborts the RPC.
+func listPageError(err error, startingToken, resources string) error {
+	if startingToken != "" && util.GetHttpStatusCode(err) == http.StatusBadRequest {
+		return status.Errorf(codes.Aborted, "invalid starting token %q", startingToken)
+	}
+	return status.Errorf(codes.Internal, "failed to list %s: %v", resources, err)
+}
+
+// isClusterVolume returns true if the volume is an existing volume of the
+// cluster.
+func (d *BlockVolumeControllerDriver) isClusterVolume(volume core.Volume) bool {
+	if volume.LifecycleState == core.VolumeLifecycleStateTerminating ||
+		volume.LifecycleState == core.VolumeLifecycleStateTerminated {
+		return false
+	}
+	cfg := d.config.BlockVolume
+	if len(cfg.FreeformTags) > 0 {
+		for k, v := range cfg.FreeformTags {
+			if value, ok := volume.FreeformTags[k]; !ok || value != v {
+				return false
+			}
+		}
+		return true
+	}
+	return volume.DisplayName != nil && strings.HasPrefix(*volume.DisplayName, cfg.GetVolumeNamePrefix()+"-")
+}
+
+// publishedNodeIDs returns the names of the nodes the volumes of the
+// compartment are attached to, by volume ID.
+func (d *BlockVolumeControllerDriver) publishedNodeIDs(ctx context.Context) (map[string][]string, error) {
+	attachments, err := d.client.Compute().ListVolumeAttachments(ctx, d.config.CompartmentID, "")
+	if err != nil && !client.IsNotFound(err) {
+		return nil, err
+	}
+	published := map[string][]string{}
+	if len(attachments) == 0 {
+		return published, nil
+	}
+
+	nodeNames, err := d.nodeNamesByInstanceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateAttached ||
+			attachment.GetInstanceId() == nil || attachment.GetVolumeId() == nil {
+			continue
+		}
+		nodeName, ok := nodeNames[*attachment.GetInstanceId()]
+		if !ok {
+			continue
+		}
+		volumeID := *attachment.GetVolumeId()
+		published[volumeID] = append(published[volumeID], nodeName)
+	}
+	return published, nil
+}
+
+// nodeNamesByInstanceID returns the names of the nodes of the cluster by the
+// OCID of their instance.
+func (d *BlockVolumeControllerDriver) nodeNamesByInstanceID(ctx context.Context) (map[string]string, error) {
+	nodes, err := d.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list nodes")
+	}
+	nodeNames := make(map[string]string, len(nodes.Items))
+	for _, node := range nodes.Items {
+		nodeNames[client.MapProviderIDToInstanceID(node.Spec.ProviderID)] = node.Name
+	}
+	return nodeNames, nil
 }
 
-// GetCapacity returns the capacity of the storage pool
+// GetCapacity returns the block volume storage available in the availability
+// domain of the topology, from the limits and quotas of the compartment.
 func (d *BlockVolumeControllerDriver) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	if !d.capacityEnabled() {
+		return nil, status.Error(codes.Unimplemented, "")
+	}
+
+	availableGB, err := d.availableCapacity(ctx, client.BlockStorageLimitsService, client.TotalStorageGBLimit,
+		d.config.CompartmentID, topologyAvailabilityDomain(req.AccessibleTopology))
+	if err != nil {
+		return nil, err
+	}
+
+	return &csi.GetCapacityResponse{
+		AvailableCapacity: availableGB * client.GiB,
+	}, nil
 }
 
 // ControllerGetCapabilities returns the capabilities of the controller service.
@@ -1103,10 +1605,24 @@ func (d *BlockVolumeControllerDriver) ControllerGetCapabilities(ctx context.Cont
 		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
+		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
+		csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
 	} {
 		caps = append(caps, newCap(cap))
 	}
+	caps = append(caps,
+		newCap(csi.ControllerServiceCapability_RPC_MODIFY_VOLUME),
+		newCap(csi.ControllerServiceCapability_RPC_GET_VOLUME),
+		newCap(csi.ControllerServiceCapability_RPC_VOLUME_CONDITION))
+	if d.capacityEnabled() {
+		caps = append(caps, newCap(csi.ControllerServiceCapability_RPC_GET_CAPACITY))
+	}
+	if d.listVolumesEnabled() {
+		caps = append(caps,
+			newCap(csi.ControllerServiceCapability_RPC_LIST_VOLUMES),
+			newCap(csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES))
+	}
 
 	resp := &csi.ControllerGetCapabilitiesResponse{
 		Capabilities: caps,
@@ -1185,6 +1701,15 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		return nil, status.Error(codes.InvalidArgument, "Volume snapshot feature not available for boot volumes")
 	}
 
+	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
+	}
+
 	snapshots, err := d.client.BlockStorage().GetVolumeBackupsByName(ctx, req.Name, d.config.CompartmentID)
 	if err != nil {
 		errorType = util.GetError(err)
@@ -1248,6 +1773,13 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 			}
 		}
 
+		if err = d.copyVolumeBackup(ctx, log, snapshot, snapshotParams.copyRegions, req.GetParameters()); err != nil {
+			snapshotMetricDimension = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, err
+		}
+
 		log.Info("Snapshot is created and available.")
 		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
@@ -1263,15 +1795,6 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}, nil
 	}
 
-	snapshotParams, err := extractSnapshotParameters(req.GetParameters())
-	if err != nil {
-		log.With(zap.Error(err)).Error("Failed to parse volumesnapshotclass parameters.")
-		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
-		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
-		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse volumesnapshotclass parameters %v", err)
-	}
-
 	backupTags := &config.TagConfig{
 		FreeformTags: snapshotParams.freeformTags,
 		DefinedTags:  snapshotParams.definedTags,
@@ -1320,6 +1843,13 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 		}
 	}
 
+	if err = d.copyVolumeBackup(ctx, log, *snapshot, snapshotParams.copyRegions, req.GetParameters()); err != nil {
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
 	log.Info("Snapshot is created and available.")
 	snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 	dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
@@ -1336,45 +1866,296 @@ func (d *BlockVolumeControllerDriver) CreateSnapshot(ctx context.Context, req *c
 	}, nil
 }
 
-// DeleteSnapshot will be called by the CO to delete a snapshot.
-func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
-	startTime := time.Now()
-	var snapshotMetricDimension string
-	var errorType string
-	dimensionsMap := make(map[string]string)
-	dimensionsMap[metrics.ResourceOCIDDimension] = req.SnapshotId
+// copyVolumeBackup starts copying the available volume backup to the regions it
+// is not copied to yet, without waiting for the copies to complete, and
+// annotates the volume snapshot and its content with the OCIDs of the copies.
+func (d *BlockVolumeControllerDriver) copyVolumeBackup(ctx context.Context, log *zap.SugaredLogger, volumeBackup core.VolumeBackup,
+	regions []string, parameters map[string]string) error {
+	if len(regions) == 0 {
+		return nil
+	}
 
-	log := d.logger.With("SnapshotId", req.SnapshotId, "csiOperation", "deleteSnapshot")
+	copies := make(map[string]string, len(regions))
+	for _, region := range regions {
+		log := log.With("destinationRegion", region)
+		regionalBlockStorage, err := d.client.BlockStorage().ForRegion(region)
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to get the block storage client of the region.")
+			return status.Errorf(codes.Internal, "failed to get the block storage client of region %s: %v", region, err)
+		}
 
-	if req.SnapshotId == "" {
-		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
-		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
-		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
-		log.Errorf("SnapshotID is empty")
-		return nil, status.Error(codes.InvalidArgument, "SnapshotId must be provided")
-	}
+		backupCopies, err := regionalBlockStorage.GetVolumeBackupCopies(ctx, *volumeBackup.Id, *volumeBackup.CompartmentId)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to check the existence of the backup copy.")
+			return status.Errorf(codes.Internal, "failed to check existence of the copy of snapshot %s in region %s: %v", *volumeBackup.Id, region, err)
+		}
+		if len(backupCopies) > 0 {
+			copies[region] = *backupCopies[0].Id
+			continue
+		}
 
-	err := d.client.BlockStorage().DeleteVolumeBackup(ctx, req.SnapshotId)
-	if err != nil && !k8sapierrors.IsNotFound(err) {
-		errorType = util.GetError(err)
-		snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
-		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
-		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
-		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
-			Errorf("Failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
-		return nil, fmt.Errorf("failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
+		backupCopy, err := d.client.BlockStorage().CopyVolumeBackup(ctx, *volumeBackup.Id, region, *volumeBackup.DisplayName)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "copy", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to copy the backup.")
+			return status.Errorf(codes.Internal, "failed to copy snapshot %s to region %s: %v", *volumeBackup.Id, region, err)
+		}
+		log.With("volumeBackupCopyId", *backupCopy.Id).Info("Started copying the backup.")
+		copies[region] = *backupCopy.Id
 	}
 
-	log.Info("Snapshot is deleted.")
+	return d.annotateVolumeSnapshot(ctx, log, copies, parameters)
+}
+
+// annotateVolumeSnapshot sets the backup copies annotation of the volume
+// snapshot and the volume snapshot content of the parameters, which are passed
+// by the csi-snapshotter when started with --extra-create-metadata.
+func (d *BlockVolumeControllerDriver) annotateVolumeSnapshot(ctx context.Context, log *zap.SugaredLogger, copies map[string]string,
+	parameters map[string]string) error {
+	contentName := parameters[volumeSnapshotContentNameKey]
+	if contentName == "" || d.SnapshotClient == nil {
+		log.Warnf("Volume snapshot metadata is not available, not annotating the volume snapshot with the backup copies. "+
+			"Start the csi-snapshotter with --extra-create-metadata to set the %s annotation.", backupCopiesAnnotation)
+		return nil
+	}
+
+	copiesJSON, err := json.Marshal(copies)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode the backup copies: %v", err)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{backupCopiesAnnotation: string(copiesJSON)},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode the backup copies annotation: %v", err)
+	}
+
+	_, err = d.SnapshotClient.SnapshotV1().VolumeSnapshotContents().Patch(ctx, contentName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		log.With("volumeSnapshotContent", contentName).With(zap.Error(err)).Error("Failed to annotate the volume snapshot content.")
+		return status.Errorf(codes.Internal, "failed to annotate volume snapshot content %s: %v", contentName, err)
+	}
+
+	name, namespace := parameters[volumeSnapshotNameKey], parameters[volumeSnapshotNamespaceKey]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	_, err = d.SnapshotClient.SnapshotV1().VolumeSnapshots(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		log.With("volumeSnapshot", namespace+"/"+name).With(zap.Error(err)).Error("Failed to annotate the volume snapshot.")
+		return status.Errorf(codes.Internal, "failed to annotate volume snapshot %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// volumeBackupCopy returns the copy in the region of the cluster of the volume
+// backup of the source region, and starts copying the volume backup if it is
+// not copied yet. It returns DeadlineExceeded with the progress of the copy
+// until the copy is available, for the provisioner to retry.
+func (d *BlockVolumeControllerDriver) volumeBackupCopy(ctx context.Context, log *zap.SugaredLogger, id, sourceRegion string) (*core.VolumeBackup, error) {
+	log = log.With("volumeBackupId", id, "backupSourceRegion", sourceRegion)
+	region := d.config.Auth.Region
+	if region == "" {
+		log.Error("The region of the cluster is unknown, cannot copy the backup.")
+		return nil, status.Errorf(codes.FailedPrecondition, "the region of the cluster is unknown, cannot copy snapshot %s from region %s", id, sourceRegion)
+	}
+
+	sourceBlockStorage, err := d.client.BlockStorage().ForRegion(sourceRegion)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the block storage client of the backup source region.")
+		return nil, status.Errorf(codes.Internal, "failed to get the block storage client of region %s: %v", sourceRegion, err)
+	}
+
+	volumeBackup, err := sourceBlockStorage.GetVolumeBackup(ctx, id)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the backup in the backup source region.")
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Failed to get snapshot with ID %v in region %s", id, sourceRegion)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch snapshot with ID %v in region %s with error %v", id, sourceRegion, err)
+	}
+
+	backupCopies, err := d.client.BlockStorage().GetVolumeBackupCopies(ctx, id, *volumeBackup.CompartmentId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the backup copy.")
+		return nil, status.Errorf(codes.Internal, "failed to check existence of the copy of snapshot %s: %v", id, err)
+	}
+
+	if len(backupCopies) == 0 {
+		if volumeBackup.LifecycleState != core.VolumeBackupLifecycleStateAvailable {
+			return nil, status.Errorf(codes.Unavailable, "snapshot %s in region %s is %s, it can only be copied once available",
+				id, sourceRegion, volumeBackup.LifecycleState)
+		}
+		backupCopy, err := sourceBlockStorage.CopyVolumeBackup(ctx, id, region, *volumeBackup.DisplayName)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "copy", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to copy the backup to the region of the cluster.")
+			return nil, status.Errorf(codes.Internal, "failed to copy snapshot %s from region %s: %v", id, sourceRegion, err)
+		}
+		log.With("volumeBackupCopyId", *backupCopy.Id).Info("Started copying the backup to the region of the cluster.")
+		backupCopies = append(backupCopies, *backupCopy)
+	}
+
+	backupCopy := backupCopies[0]
+	if backupCopy.LifecycleState != core.VolumeBackupLifecycleStateAvailable {
+		progress := fmt.Sprintf("copy %s is %s", *backupCopy.Id, backupCopy.LifecycleState)
+		if backupCopy.TimeCreated != nil {
+			progress = fmt.Sprintf("%s since %s", progress, time.Since(backupCopy.TimeCreated.Time).Round(time.Second))
+		}
+		log.Infof("Waiting for the backup to be copied to the region of the cluster, %s.", progress)
+		return nil, status.Errorf(codes.DeadlineExceeded, "copying snapshot %s from region %s, %s", id, sourceRegion, progress)
+	}
+	return &backupCopy, nil
+}
+
+// DeleteSnapshot will be called by the CO to delete a snapshot.
+func (d *BlockVolumeControllerDriver) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
+	startTime := time.Now()
+	var snapshotMetricDimension string
+	var errorType string
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.SnapshotId
+
+	log := d.logger.With("SnapshotId", req.SnapshotId, "csiOperation", "deleteSnapshot")
+
+	if req.SnapshotId == "" {
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		log.Errorf("SnapshotID is empty")
+		return nil, status.Error(codes.InvalidArgument, "SnapshotId must be provided")
+	}
+
+	if strings.HasPrefix(req.SnapshotId, blockVolumeReplicaIDPrefix) {
+		// Replicas are torn down with the replication of their source volume
+		log.Info("Snapshot is a block volume replica, which is not deleted with the snapshot.")
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		return &csi.DeleteSnapshotResponse{}, nil
+	}
+
+	err := d.client.BlockStorage().DeleteVolumeBackup(ctx, req.SnapshotId)
+	if err != nil && !k8sapierrors.IsNotFound(err) {
+		errorType = util.GetError(err)
+		snapshotMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+		log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			Errorf("Failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
+		return nil, fmt.Errorf("failed to delete snapshot, snapshotId: %s, error: %v", req.SnapshotId, err)
+	}
+
+	log.Info("Snapshot is deleted.")
 	snapshotMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
 	dimensionsMap[metrics.ComponentDimension] = snapshotMetricDimension
 	metrics.SendMetricData(d.metricPusher, metrics.BlockSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
 	return &csi.DeleteSnapshotResponse{}, nil
 }
 
-// ListSnapshots returns all the matched snapshots
+// ListSnapshots returns the snapshot of the snapshot ID if provided, or
+// otherwise a page of the snapshots of the compartment, of the source volume
+// if provided.
 func (d *BlockVolumeControllerDriver) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ListSnapshots is not supported yet")
+	if req.MaxEntries < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid max entries %d", req.MaxEntries)
+	}
+	log := d.logger.With("snapshotId", req.SnapshotId, "sourceVolumeId", req.SourceVolumeId,
+		"startingToken", req.StartingToken, "csiOperation", "listSnapshots")
+
+	if strings.HasPrefix(req.SnapshotId, blockVolumeReplicaIDPrefix) {
+		return d.listBlockVolumeReplicaSnapshot(ctx, log, req.SnapshotId, req.SourceVolumeId)
+	}
+
+	if req.SnapshotId != "" {
+		backup, err := d.client.BlockStorage().GetVolumeBackup(ctx, req.SnapshotId)
+		if client.IsNotFound(err) {
+			// The snapshot of a backup of another region to restore from
+			if region := d.otherRegion(req.SnapshotId); region != "" {
+				var regionalBlockStorage client.BlockStorageInterface
+				if regionalBlockStorage, err = d.client.BlockStorage().ForRegion(region); err == nil {
+					backup, err = regionalBlockStorage.GetVolumeBackup(ctx, req.SnapshotId)
+				}
+			}
+		}
+		if err != nil {
+			if client.IsNotFound(err) {
+				return &csi.ListSnapshotsResponse{}, nil
+			}
+			log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get the snapshot.")
+			return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", req.SnapshotId, err)
+		}
+		resp := &csi.ListSnapshotsResponse{}
+		entry := snapshotEntry(*backup)
+		if entry != nil && (req.SourceVolumeId == "" || entry.Snapshot.SourceVolumeId == req.SourceVolumeId) {
+			resp.Entries = append(resp.Entries, entry)
+		}
+		return resp, nil
+	}
+
+	backups, nextPage, err := d.client.BlockStorage().ListVolumeBackups(ctx, d.config.CompartmentID, req.SourceVolumeId,
+		int(req.MaxEntries), req.StartingToken)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to list the snapshots.")
+		return nil, listPageError(err, req.StartingToken, "snapshots")
+	}
+
+	entries := make([]*csi.ListSnapshotsResponse_Entry, 0, len(backups))
+	for _, backup := range backups {
+		if entry := snapshotEntry(backup); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return &csi.ListSnapshotsResponse{
+		Entries:   entries,
+		NextToken: nextPage,
+	}, nil
+}
+
+// otherRegion returns the region of the OCID of the volume backup or block
+// volume replica if it is not the region of the cluster, empty otherwise.
+func (d *BlockVolumeControllerDriver) otherRegion(id string) string {
+	parts := strings.Split(id, ".")
+	if len(parts) != 5 || parts[3] == "" {
+		return ""
+	}
+	region := string(common.StringToRegion(parts[3]))
+	if region == d.config.Auth.Region || (d.config.RegionKey != "" && region == string(common.StringToRegion(d.config.RegionKey))) {
+		return ""
+	}
+	return region
+}
+
+// snapshotEntry returns the ListSnapshots entry of the volume backup, nil if
+// the backup is deleted.
+func snapshotEntry(backup core.VolumeBackup) *csi.ListSnapshotsResponse_Entry {
+	if backup.Id == nil ||
+		backup.LifecycleState == core.VolumeBackupLifecycleStateTerminating ||
+		backup.LifecycleState == core.VolumeBackupLifecycleStateTerminated {
+		return nil
+	}
+	ready, _ := isBlockVolumeAvailable(backup)
+	snapshot := &csi.Snapshot{
+		SnapshotId: *backup.Id,
+		ReadyToUse: ready,
+	}
+	if backup.VolumeId != nil {
+		snapshot.SourceVolumeId = *backup.VolumeId
+	}
+	if backup.SizeInMBs != nil {
+		snapshot.SizeBytes = *backup.SizeInMBs * client.MiB
+	}
+	if backup.TimeCreated != nil {
+		snapshot.CreationTime = timestamppb.New(backup.TimeCreated.Time)
+	}
+	return &csi.ListSnapshotsResponse_Entry{Snapshot: snapshot}
 }
 
 // ControllerExpandVolume returns ControllerExpandVolume request
@@ -1472,17 +2253,417 @@ func (d *BlockVolumeControllerDriver) ControllerExpandVolume(ctx context.Context
 	}, nil
 }
 
-// ControllerGetVolume returns ControllerGetVolumeResponse response
+// ControllerGetVolume returns the volume with the nodes it is published to and
+// its condition, from the lifecycle state of the volume and the state of its
+// attachments. The volume context of block volumes is their performance tier.
 func (d *BlockVolumeControllerDriver) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerGetVolume is not supported yet")
+	volumeID := req.GetVolumeId()
+	if len(volumeID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID must be provided")
+	}
+	log := d.logger.With("volumeID", volumeID)
+
+	var (
+		lifecycleState string
+		sizeInMBs      *int64
+		volumeContext  map[string]string
+	)
+	if client.IsBootVolume(volumeID) {
+		bootVolume, err := d.client.BlockStorage().GetBootVolume(ctx, volumeID)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "get", "resource", "bootVolume", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get boot volume.")
+			return nil, status.Errorf(codes.Internal, "failed to get boot volume: %v", err)
+		}
+		if bootVolume == nil || bootVolume.LifecycleState == core.BootVolumeLifecycleStateTerminated {
+			return nil, status.Errorf(codes.NotFound, "Boot volume %s not found", volumeID)
+		}
+		lifecycleState, sizeInMBs = string(bootVolume.LifecycleState), bootVolume.SizeInMBs
+	} else {
+		volume, err := d.client.BlockStorage().GetVolume(ctx, volumeID)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get volume.")
+			return nil, status.Errorf(codes.Internal, "failed to get volume: %v", err)
+		}
+		if volume == nil || volume.LifecycleState == core.VolumeLifecycleStateTerminated {
+			return nil, status.Errorf(codes.NotFound, "Volume %s not found", volumeID)
+		}
+		lifecycleState, sizeInMBs = string(volume.LifecycleState), volume.SizeInMBs
+		volumeContext = volumePerformanceTier(volume)
+	}
+
+	attachments, err := d.client.Compute().ListVolumeAttachments(ctx, d.config.CompartmentID, volumeID)
+	if err != nil && !client.IsNotFound(err) {
+		log.With(zap.Error(err)).Error("Failed to list volume attachments.")
+		return nil, status.Errorf(codes.Internal, "failed to list volume attachments: %v", err)
+	}
+	var nodeNames map[string]string
+	if len(attachments) > 0 {
+		if nodeNames, err = d.nodeNamesByInstanceID(ctx); err != nil {
+			log.With(zap.Error(err)).Error("Failed to list nodes.")
+			return nil, status.Errorf(codes.Internal, "failed to list nodes: %v", err)
+		}
+	}
+
+	var publishedNodeIDs []string
+	for _, attachment := range attachments {
+		if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateAttached || attachment.GetInstanceId() == nil {
+			continue
+		}
+		if nodeName, ok := nodeNames[*attachment.GetInstanceId()]; ok {
+			publishedNodeIDs = append(publishedNodeIDs, nodeName)
+		}
+	}
+
+	resp := &csi.ControllerGetVolumeResponse{
+		Volume: &csi.Volume{
+			VolumeId:      volumeID,
+			VolumeContext: volumeContext,
+		},
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			PublishedNodeIds: publishedNodeIDs,
+			VolumeCondition:  volumeCondition(lifecycleState, attachments, nodeNames),
+		},
+	}
+	if sizeInMBs != nil {
+		resp.Volume.CapacityBytes = *sizeInMBs * client.MiB
+	}
+	return resp, nil
+}
+
+// volumeAutotunePolicies returns whether the detached volume autotune policy of
+// the volume is enabled, and the maximum performance level of its performance
+// based autotune policy, 0 if it is disabled.
+func volumeAutotunePolicies(volume *core.Volume) (detachedAutotune bool, maxVpusPerGB int64) {
+	for _, policy := range volume.AutotunePolicies {
+		switch policy := policy.(type) {
+		case core.DetachedVolumeAutotunePolicy:
+			detachedAutotune = true
+		case core.PerformanceBasedAutotunePolicy:
+			if policy.MaxVpusPerGB != nil {
+				maxVpusPerGB = *policy.MaxVpusPerGB
+			}
+		}
+	}
+	return detachedAutotune, maxVpusPerGB
+}
+
+// volumePerformanceTier returns the configured and the autotuned performance
+// levels of the volume, with its autotune policies.
+func volumePerformanceTier(volume *core.Volume) map[string]string {
+	if volume.VpusPerGB == nil {
+		return nil
+	}
+	autoTunedVpusPerGB := *volume.VpusPerGB
+	if volume.AutoTunedVpusPerGB != nil {
+		autoTunedVpusPerGB = *volume.AutoTunedVpusPerGB
+	}
+	detachedAutotune, maxVpusPerGB := volumeAutotunePolicies(volume)
+	return map[string]string{
+		csi_util.VpusPerGB:    strconv.FormatInt(*volume.VpusPerGB, 10),
+		autoTunedVpusPerGBKey: strconv.FormatInt(autoTunedVpusPerGB, 10),
+		detachedAutotuneKey:   strconv.FormatBool(detachedAutotune),
+		maxVpusPerGBKey:       strconv.FormatInt(maxVpusPerGB, 10),
+	}
+}
+
+// addPerformanceTier adds the configured and the autotuned performance levels
+// of the volume, with its autotune policies, to the publish context, for the
+// node driver to report them without calling the API. The configured level of
+// the volume replaces the one of the volume context, which is stale once the
+// volume is modified.
+func (d *BlockVolumeControllerDriver) addPerformanceTier(ctx context.Context, log *zap.SugaredLogger, volumeID string, publishContext map[string]string) {
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeID)
+	if err != nil || volume == nil {
+		log.With(zap.Error(err)).Warn("Failed to get the performance tier of the volume, publishing it without.")
+		return
+	}
+	for key, value := range volumePerformanceTier(volume) {
+		publishContext[key] = value
+	}
+}
+
+// mutableVolumeParameters are the volume parameters ControllerModifyVolume can change.
+var mutableVolumeParameters = map[string]bool{
+	csi_util.VpusPerGB:          true,
+	detachedAutotuneKey:         true,
+	maxVpusPerGBKey:             true,
+	initialFreeformTagsOverride: true,
+	initialDefinedTagsOverride:  true,
+	kmsKey:                      true,
+}
+
+// ControllerModifyVolume changes the performance level, autotune policies, tags and KMS key of a volume, from the
+// mutable parameters of its VolumeAttributesClass. The function is idempotent.
+func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
+	startTime := time.Now()
+	volumeId := req.GetVolumeId()
+	if volumeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ModifyVolume volumeId must be provided")
+	}
+	log := d.logger.With("volumeID", volumeId, "csiOperation", "modifyVolume")
+	var errorType string
+	var csiMetricDimension string
+
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = volumeId
+
+	if client.IsBootVolume(volumeId) {
+		log.Error("Volume modification is not supported for Boot Volumes")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.InvalidArgument, "Volume modification is not supported for Boot Volumes")
+	}
+
+	for k := range req.GetMutableParameters() {
+		if !mutableVolumeParameters[k] {
+			csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Errorf(codes.InvalidArgument, "parameter %s cannot be modified", k)
+		}
+	}
+
+	volumeParams, err := extractVolumeParameters(log, req.GetMutableParameters())
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to parse mutable parameters.")
+		csiMetricDimension = util.GetMetricDimensionForComponent(util.ErrValidation, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume.")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get volume %v", err)
+	}
+	log = log.With("volumeName", volume.DisplayName)
+
+	updateVolumeDetails, err := d.modifiedVolumeDetails(ctx, volume, req.GetMutableParameters(), volumeParams)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Invalid volume modification.")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, err
+	}
+
+	if updateVolumeDetails != nil {
+		_, err = d.client.BlockStorage().UpdateVolume(ctx, volumeId, *updateVolumeDetails)
+		if err != nil {
+			message := fmt.Sprintf("Update volume failed %v", err)
+			log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				Error(message)
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Error(codes.Internal, message)
+		}
+	}
+
+	kmsKeyChanged := volumeParams.diskEncryptionKey != "" &&
+		(volume.KmsKeyId == nil || *volume.KmsKeyId != volumeParams.diskEncryptionKey)
+	if kmsKeyChanged {
+		err = d.client.BlockStorage().UpdateVolumeKmsKey(ctx, volumeId, volumeParams.diskEncryptionKey)
+		if err != nil {
+			message := fmt.Sprintf("Update volume KMS key failed %v", err)
+			log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				Error(message)
+			errorType = util.GetError(err)
+			csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+			dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+			metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+			return nil, status.Error(codes.Internal, message)
+		}
+	}
+
+	if updateVolumeDetails == nil && !kmsKeyChanged {
+		log.Info("Volume already has the requested parameters. No action needed.")
+		return &csi.ControllerModifyVolumeResponse{}, nil
+	}
+
+	_, err = d.client.BlockStorage().AwaitVolumeAvailableORTimeout(ctx, volumeId)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			Error("Volume modification failed with time out")
+		errorType = util.GetError(err)
+		csiMetricDimension = util.GetMetricDimensionForComponent(errorType, util.CSIStorageType)
+		dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+		metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+		return nil, status.Errorf(codes.DeadlineExceeded, "ControllerModifyVolume failed with time out %v", err.Error())
+	}
+
+	log.Info("Volume is modified.")
+	csiMetricDimension = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	dimensionsMap[metrics.ComponentDimension] = csiMetricDimension
+	metrics.SendMetricData(d.metricPusher, metrics.PVUpdate, time.Since(startTime).Seconds(), dimensionsMap)
+
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
+// autotunePolicies returns the autotune policies of the volume, an empty list if autotune is disabled.
+func autotunePolicies(detachedAutotune bool, maxVpusPerGB int64) []core.AutotunePolicy {
+	policies := []core.AutotunePolicy{}
+	if detachedAutotune {
+		policies = append(policies, core.DetachedVolumeAutotunePolicy{})
+	}
+	if maxVpusPerGB != 0 {
+		policies = append(policies, core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: &maxVpusPerGB})
+	}
+	return policies
+}
+
+// validateAutotunePolicies rejects the autotune policies OCI does not support for the performance level.
+func validateAutotunePolicies(vpusPerGB int64, detachedAutotune bool, maxVpusPerGB int64) error {
+	if detachedAutotune && vpusPerGB >= ultraHighPerformanceVpusPerGB {
+		return status.Errorf(codes.InvalidArgument, "%s is not supported for Ultra High Performance volumes (vpusPerGB >= %d)",
+			detachedAutotuneKey, ultraHighPerformanceVpusPerGB)
+	}
+	if maxVpusPerGB != 0 && maxVpusPerGB < vpusPerGB {
+		return status.Errorf(codes.InvalidArgument, "%s %d must not be lower than vpusPerGB %d", maxVpusPerGBKey, maxVpusPerGB, vpusPerGB)
+	}
+	return nil
+}
+
+// applyPVCAutotuneOverrides returns the volume parameters with the autotune policies overridden by the annotations
+// of the persistent volume claim, whose name and namespace the csi-provisioner passes with --extra-create-metadata.
+func (d *BlockVolumeControllerDriver) applyPVCAutotuneOverrides(ctx context.Context, log *zap.SugaredLogger, parameters map[string]string,
+	volumeParams VolumeParameters) (VolumeParameters, error) {
+	pvcName, pvcNamespace := parameters[pvcNameKey], parameters[pvcNamespaceKey]
+	if pvcName == "" || pvcNamespace == "" || d.KubeClient == nil {
+		return volumeParams, nil
+	}
+	pvc, err := d.KubeClient.CoreV1().PersistentVolumeClaims(pvcNamespace).Get(ctx, pvcName, metav1.GetOptions{})
+	if err != nil {
+		if k8sapierrors.IsNotFound(err) {
+			log.With("pvcName", pvcName, "pvcNamespace", pvcNamespace).Warn("Persistent volume claim not found, ignoring its autotune annotations.")
+			return volumeParams, nil
+		}
+		return volumeParams, status.Errorf(codes.Internal, "failed to get persistent volume claim %s/%s: %v", pvcNamespace, pvcName, err)
+	}
+	if v, ok := pvc.Annotations[detachedAutotuneAnnotation]; ok {
+		detachedAutotune, err := strconv.ParseBool(v)
+		if err != nil {
+			return volumeParams, status.Errorf(codes.InvalidArgument, "invalid %s annotation: %s, it must be true or false", detachedAutotuneAnnotation, v)
+		}
+		volumeParams.detachedAutotune = detachedAutotune
+	}
+	if v, ok := pvc.Annotations[maxVpusPerGBAnnotation]; ok {
+		maxVpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
+		if err != nil {
+			return volumeParams, status.Errorf(codes.InvalidArgument, "invalid %s annotation: %v", maxVpusPerGBAnnotation, err)
+		}
+		volumeParams.maxVpusPerGB = maxVpusPerGB
+	}
+	return volumeParams, nil
 }
 
-func (d *BlockVolumeControllerDriver) ControllerModifyVolume(ctx context.Context, request *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "ControllerModifyVolume is not supported yet")
+// modifiedVolumeDetails returns the update of the volume to the given mutable parameters, nil if the volume already
+// has them. Only the parameters present in mutableParameters are changed, tags are merged into the existing ones.
+func (d *BlockVolumeControllerDriver) modifiedVolumeDetails(ctx context.Context, volume *core.Volume, mutableParameters map[string]string,
+	volumeParams VolumeParameters) (*core.UpdateVolumeDetails, error) {
+	details := core.UpdateVolumeDetails{DisplayName: volume.DisplayName}
+	changed := false
+
+	vpusPerGB := int64(0)
+	if volume.VpusPerGB != nil {
+		vpusPerGB = *volume.VpusPerGB
+	}
+	if _, ok := mutableParameters[csi_util.VpusPerGB]; ok && volumeParams.vpusPerGB != vpusPerGB {
+		// Ultra High Performance volumes need multipath attachments, which are only set up when the volume is attached
+		if (vpusPerGB >= ultraHighPerformanceVpusPerGB) != (volumeParams.vpusPerGB >= ultraHighPerformanceVpusPerGB) {
+			attachments, err := d.client.Compute().ListVolumeAttachments(ctx, *volume.CompartmentId, *volume.Id)
+			if err != nil && !client.IsNotFound(err) {
+				return nil, status.Errorf(codes.Internal, "failed to list the volume attachments %v", err)
+			}
+			if len(attachments) > 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "vpusPerGB of an attached volume cannot be changed from %d to %d, "+
+					"across the Ultra High Performance level %d", vpusPerGB, volumeParams.vpusPerGB, ultraHighPerformanceVpusPerGB)
+			}
+		}
+		vpusPerGB = volumeParams.vpusPerGB
+		details.VpusPerGB = &vpusPerGB
+		changed = true
+	}
+
+	detachedAutotune, maxVpusPerGB := volumeAutotunePolicies(volume)
+	newDetachedAutotune, newMaxVpusPerGB := detachedAutotune, maxVpusPerGB
+	if _, ok := mutableParameters[detachedAutotuneKey]; ok {
+		newDetachedAutotune = volumeParams.detachedAutotune
+	}
+	if _, ok := mutableParameters[maxVpusPerGBKey]; ok {
+		newMaxVpusPerGB = volumeParams.maxVpusPerGB
+	}
+	if err := validateAutotunePolicies(vpusPerGB, newDetachedAutotune, newMaxVpusPerGB); err != nil {
+		return nil, err
+	}
+	if newDetachedAutotune != detachedAutotune || newMaxVpusPerGB != maxVpusPerGB {
+		// an empty list of policies disables autotune
+		details.AutotunePolicies = autotunePolicies(newDetachedAutotune, newMaxVpusPerGB)
+		changed = true
+	}
+
+	if len(volumeParams.freeformTags) > 0 {
+		freeformTags := make(map[string]string)
+		for k, v := range volume.FreeformTags {
+			freeformTags[k] = v
+		}
+		for k, v := range volumeParams.freeformTags {
+			if current, ok := volume.FreeformTags[k]; !ok || current != v {
+				changed = true
+			}
+			freeformTags[k] = v
+		}
+		details.FreeformTags = freeformTags
+	}
+
+	if len(volumeParams.definedTags) > 0 {
+		definedTags := make(map[string]map[string]interface{})
+		for namespace, tags := range volume.DefinedTags {
+			definedTags[namespace] = make(map[string]interface{})
+			for k, v := range tags {
+				definedTags[namespace][k] = v
+			}
+		}
+		for namespace, tags := range volumeParams.definedTags {
+			if _, ok := definedTags[namespace]; !ok {
+				definedTags[namespace] = make(map[string]interface{})
+			}
+			for k, v := range tags {
+				if current, ok := definedTags[namespace][k]; !ok || !reflect.DeepEqual(current, v) {
+					changed = true
+				}
+				definedTags[namespace][k] = v
+			}
+		}
+		if len(definedTags) > MaxDefinedTagPerVolume {
+			return nil, status.Errorf(codes.InvalidArgument, "the volume would have more than %d defined tags", MaxDefinedTagPerVolume)
+		}
+		details.DefinedTags = definedTags
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return &details, nil
 }
 
 func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volName string, volSize int64, availDomainName, compartmentID,
-	backupID, srcVolumeID, kmsKeyID string, vpusPerGB int64, bvTags *config.TagConfig) (core.Volume, error) {
+	backupID, srcVolumeID, kmsKeyID string, vpusPerGB int64, bvTags *config.TagConfig, backupPolicyID string,
+	autotunePolicies []core.AutotunePolicy, replicas []core.BlockVolumeReplicaDetails) (core.Volume, error) {
 
 	volSizeGB, minSizeGB := csi_util.RoundUpSize(volSize, 1*client.GiB), csi_util.RoundUpMinSize()
 
@@ -1498,7 +2679,9 @@ func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface,
 		VpusPerGB:          &vpusPerGB,
 	}
 
-	if backupID != "" {
+	if strings.HasPrefix(backupID, blockVolumeReplicaIDPrefix) {
+		volumeDetails.SourceDetails = &core.VolumeSourceFromBlockVolumeReplicaDetails{Id: &backupID}
+	} else if backupID != "" {
 		volumeDetails.SourceDetails = &core.VolumeSourceFromVolumeBackupDetails{Id: &backupID}
 	} else if srcVolumeID != "" {
 		volumeDetails.SourceDetails = &core.VolumeSourceFromVolumeDetails{Id: &srcVolumeID}
@@ -1507,6 +2690,15 @@ func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface,
 	if kmsKeyID != "" {
 		volumeDetails.KmsKeyId = &kmsKeyID
 	}
+	if backupPolicyID != "" {
+		volumeDetails.BackupPolicyId = &backupPolicyID
+	}
+	if len(autotunePolicies) > 0 {
+		volumeDetails.AutotunePolicies = autotunePolicies
+	}
+	if len(replicas) > 0 {
+		volumeDetails.BlockVolumeReplicas = replicas
+	}
 	if bvTags != nil && bvTags.FreeformTags != nil {
 		volumeDetails.FreeformTags = bvTags.FreeformTags
 	}
@@ -1532,16 +2724,14 @@ func provision(ctx context.Context, log *zap.SugaredLogger, c client.Interface,
 }
 
 // We would derive whether the customer wants in-transit encryption or not based on if the node is launched using
-// in-transit encryption enabled or not.
-func getAttachmentOptions(ctx context.Context, client client.ComputeInterface, attachmentType, instanceID string, isShareable bool) (VolumeAttachmentOption, error) {
+// in-transit encryption enabled or not. The attachment types are chosen by the attachment policy, and in-transit
+// encryption requires the paravirtualized attachment type.
+func getAttachmentOptions(ctx context.Context, client client.ComputeInterface, policy attachmentPolicy, instanceID string, isShareable bool) (VolumeAttachmentOption, error) {
 	volumeAttachmentOption := VolumeAttachmentOption{
 		isShareable:          isShareable,
 		enforceLimit:         true, // default to true
 		maxVolumeAttachments: 1,    // default to 1 max attachment
 	}
-	if attachmentType == attachmentTypeParavirtualized {
-		volumeAttachmentOption.useParavirtualizedAttachment = true
-	}
 	instance, err := client.GetInstance(ctx, instanceID)
 	if err != nil {
 		return volumeAttachmentOption, err
@@ -1549,6 +2739,12 @@ func getAttachmentOptions(ctx context.Context, client client.ComputeInterface, a
 	if *instance.LaunchOptions.IsPvEncryptionInTransitEnabled {
 		volumeAttachmentOption.enableInTransitEncryption = true
 	}
+	attachmentTypes, err := policy.attachmentTypes(volumeAttachmentOption.enableInTransitEncryption)
+	if err != nil {
+		return volumeAttachmentOption, err
+	}
+	volumeAttachmentOption.attachmentTypes = attachmentTypes
+	volumeAttachmentOption.useParavirtualizedAttachment = attachmentTypes[0] == attachmentTypeParavirtualized
 	if isShareable {
 		volumeAttachmentOption.enforceLimit = false // we are NOT enforcing the attachment limit if the volume is shareable
 		volumeAttachmentOption.maxVolumeAttachments = 32
@@ -1559,6 +2755,11 @@ func getAttachmentOptions(ctx context.Context, client client.ComputeInterface, a
 	return volumeAttachmentOption, nil
 }
 
+// isReadOnlyAttachment returns whether the volume attachment is read-only.
+func isReadOnlyAttachment(attachment core.VolumeAttachment) bool {
+	return attachment.GetIsReadOnly() != nil && *attachment.GetIsReadOnly()
+}
+
 func isBlockVolumeAvailable(backup core.VolumeBackup) (bool, error) {
 	switch state := backup.LifecycleState; state {
 	case core.VolumeBackupLifecycleStateAvailable:
@@ -1592,3 +2793,131 @@ func getBVTags(logger *zap.SugaredLogger, tags *config.InitialTags, vp VolumePar
 	}
 	return bvTags
 }
+
+// withBackupRetentionTag returns a copy of the tags with the backup retention tag, the scheduled backups are retained
+// if the backupRetention is empty.
+func withBackupRetentionTag(bvTags *config.TagConfig, backupRetention string) *config.TagConfig {
+	if backupRetention == "" {
+		backupRetention = backupRetentionRetain
+	}
+	tags := &config.TagConfig{FreeformTags: map[string]string{backupRetentionTag: backupRetention}}
+	if bvTags != nil {
+		for k, v := range bvTags.FreeformTags {
+			if k != backupRetentionTag {
+				tags.FreeformTags[k] = v
+			}
+		}
+		tags.DefinedTags = bvTags.DefinedTags
+	}
+	return tags
+}
+
+// getVolumeBackupPolicyID returns the OCID of the volume backup policy of the backupPolicy parameter, which is either
+// the name of an Oracle-defined policy or the OCID of a custom policy.
+func getVolumeBackupPolicyID(ctx context.Context, c client.Interface, backupPolicy string) (string, error) {
+	if strings.HasPrefix(backupPolicy, backupPolicyIDPrefix) {
+		return backupPolicy, nil
+	}
+	policy, err := c.BlockStorage().GetVolumeBackupPolicyByName(ctx, backupPolicy, "")
+	if err != nil {
+		return "", err
+	}
+	return *policy.Id, nil
+}
+
+// assignVolumeBackupPolicy assigns the volume backup policy to the volume unless the volume has a policy already.
+func assignVolumeBackupPolicy(ctx context.Context, log *zap.SugaredLogger, c client.Interface, volumeID, backupPolicyID string) error {
+	log = log.With("volumeID", volumeID, "backupPolicyID", backupPolicyID)
+	assignments, err := c.BlockStorage().GetVolumeBackupPolicyAssignments(ctx, volumeID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume backup policy assignments.")
+		return err
+	}
+	for _, assignment := range assignments {
+		if *assignment.PolicyId != backupPolicyID {
+			log.With("assignedBackupPolicyID", *assignment.PolicyId).Warn("Volume has another volume backup policy assigned, leaving it in place.")
+		}
+		return nil
+	}
+	if _, err = c.BlockStorage().AssignVolumeBackupPolicy(ctx, volumeID, backupPolicyID); err != nil {
+		log.With("service", "blockstorage", "verb", "create", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to assign volume backup policy.")
+		return err
+	}
+	log.Info("Volume backup policy is assigned.")
+	return nil
+}
+
+// cleanupVolumeBackupPolicy deletes the scheduled backups of the volume if its backup retention is delete and then
+// the volume backup policy assignments of the volume.
+func (d *BlockVolumeControllerDriver) cleanupVolumeBackupPolicy(ctx context.Context, log *zap.SugaredLogger, volumeID string) error {
+	assignments, err := d.client.BlockStorage().GetVolumeBackupPolicyAssignments(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume backup policy assignments.")
+		return err
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get volume.")
+		return err
+	}
+	if volume.FreeformTags[backupRetentionTag] == backupRetentionDelete {
+		if err = d.deleteScheduledVolumeBackups(ctx, log, volumeID, *volume.CompartmentId); err != nil {
+			return err
+		}
+	}
+
+	for _, assignment := range assignments {
+		err = d.client.BlockStorage().DeleteVolumeBackupPolicyAssignment(ctx, *assignment.Id)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackupPolicyAssignment", "statusCode", util.GetHttpStatusCode(err)).
+				With("volumeBackupPolicyAssignmentID", *assignment.Id).With(zap.Error(err)).Error("Failed to delete volume backup policy assignment.")
+			return err
+		}
+		log.With("volumeBackupPolicyAssignmentID", *assignment.Id).Info("Volume backup policy assignment is deleted.")
+	}
+	return nil
+}
+
+// deleteScheduledVolumeBackups deletes the backups of the volume created by its volume backup policy.
+func (d *BlockVolumeControllerDriver) deleteScheduledVolumeBackups(ctx context.Context, log *zap.SugaredLogger, volumeID, compartmentID string) error {
+	page := ""
+	for {
+		backups, nextPage, err := d.client.BlockStorage().ListVolumeBackups(ctx, compartmentID, volumeID, 0, page)
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "list", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to list volume backups.")
+			return err
+		}
+		for _, backup := range backups {
+			if backup.SourceType != core.VolumeBackupSourceTypeScheduled ||
+				backup.LifecycleState == core.VolumeBackupLifecycleStateTerminating ||
+				backup.LifecycleState == core.VolumeBackupLifecycleStateTerminated {
+				continue
+			}
+			err = d.client.BlockStorage().DeleteVolumeBackup(ctx, *backup.Id)
+			if err != nil && !client.IsNotFound(err) {
+				log.With("service", "blockstorage", "verb", "delete", "resource", "volumeBackup", "statusCode", util.GetHttpStatusCode(err)).
+					With("volumeBackupID", *backup.Id).With(zap.Error(err)).Error("Failed to delete scheduled volume backup.")
+				return err
+			}
+			log.With("volumeBackupID", *backup.Id).Info("Scheduled volume backup is deleted.")
+		}
+		if page = nextPage; page == "" {
+			return nil
+		}
+	}
+}
@@ -17,28 +17,38 @@ package driver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	snapshotfake "github.com/kubernetes-csi/external-snapshotter/client/v6/clientset/versioned/fake"
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
 	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/logging"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	authv1 "k8s.io/api/authentication/v1"
 	kubeAPI "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/pointer"
 )
 
@@ -46,6 +56,9 @@ const (
 	testMinimumVolumeSizeInBytes int64 = 50 * client.GiB
 	testTimeout                        = 15 * time.Second
 	testPollInterval                   = 5 * time.Second
+	// testRegion is the region of the cluster
+	testRegion             = "us-ashburn-1"
+	testBackupSourceRegion = "us-phoenix-1"
 )
 
 var (
@@ -97,8 +110,42 @@ var (
 			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
 			Id:                 common.String("clone-volume-in-provisioning-state"),
 		},
+		"ocid1.volume.oc1.modify": {
+			DisplayName:      common.String("csi-modify"),
+			CompartmentId:    common.String("compartment"),
+			LifecycleState:   core.VolumeLifecycleStateAvailable,
+			Id:               common.String("ocid1.volume.oc1.modify"),
+			VpusPerGB:        common.Int64(10),
+			KmsKeyId:         common.String("ocid1.key.oc1.current"),
+			FreeformTags:     map[string]string{"team": "storage"},
+			DefinedTags:      map[string]map[string]interface{}{"ns": {"cost-center": "1"}},
+			AutotunePolicies: []core.AutotunePolicy{core.DetachedVolumeAutotunePolicy{}},
+		},
+		"ocid1.volume.oc1.attached": {
+			DisplayName:    common.String("csi-attached"),
+			CompartmentId:  common.String("compartment"),
+			LifecycleState: core.VolumeLifecycleStateAvailable,
+			Id:             common.String("ocid1.volume.oc1.attached"),
+			VpusPerGB:      common.Int64(10),
+		},
 	}
 
+	updated_volumes         = map[string]core.UpdateVolumeDetails{}
+	updated_volume_kms_keys = map[string]string{}
+
+	volume_groups        = map[string]*core.VolumeGroup{}
+	volume_group_backups = map[string]*core.VolumeGroupBackup{}
+	// volume backups of the volume group backups
+	group_volume_backups = map[string]core.VolumeBackup{}
+	// volume backups and their copies by region
+	regional_volume_backups = map[string][]core.VolumeBackup{}
+	// volume backup policy assignments by volume
+	volume_backup_policy_assignments = map[string][]core.VolumeBackupPolicyAssignment{}
+	deleted_volume_backups           = map[string]bool{}
+	deleted_volumes                  = map[string]bool{}
+	// block volume replicas by region
+	regional_block_volume_replicas = map[string][]core.BlockVolumeReplica{}
+
 	create_volume_requests = map[string]*csi.CreateVolumeRequest{
 		"volume-stuck-in-provisioning-state": {
 			Name: "volume-in-provisioning-state",
@@ -147,6 +194,12 @@ var (
 	}
 
 	volume_attachments = map[string]*core.IScsiVolumeAttachment{
+		"ocid1.volume.oc1.attached": {
+			LifecycleState: core.VolumeAttachmentLifecycleStateAttached,
+			Id:             common.String("ocid1.volumeattachment.oc1.attached"),
+			InstanceId:     common.String("ocid1.instance.oc1.node1"),
+			VolumeId:       common.String("ocid1.volume.oc1.attached"),
+		},
 		"volume-attachment-stuck-in-detaching-state": {
 			DisplayName:        common.String("volume-attachment-stuck-in-detaching-state"),
 			LifecycleState:     core.VolumeAttachmentLifecycleStateDetaching,
@@ -181,6 +234,81 @@ var (
 			InstanceId:         common.String("sample-provider-id-2"),
 			IsShareable:        common.Bool(false),
 		},
+		"shareable-volume-with-read-write-attachments": {
+			DisplayName:        common.String("shareable-volume-with-read-write-attachments"),
+			LifecycleState:     core.VolumeAttachmentLifecycleStateAttached,
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("shareable-volume-with-read-write-attachments"),
+			InstanceId:         common.String("sample-provider-id-2"),
+			IsShareable:        common.Bool(true),
+			IsReadOnly:         common.Bool(false),
+		},
+	}
+
+	listed_volumes = []core.Volume{
+		{
+			DisplayName:        common.String("csi-available"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("ocid1.volume.oc1.available"),
+			FreeformTags:       map[string]string{"cluster": "test"},
+		},
+		{
+			DisplayName:        common.String("csi-terminated"),
+			LifecycleState:     core.VolumeLifecycleStateTerminated,
+			SizeInMBs:          common.Int64(51200),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-2"),
+			Id:                 common.String("ocid1.volume.oc1.terminated"),
+			FreeformTags:       map[string]string{"cluster": "test"},
+		},
+		{
+			DisplayName:        common.String("other-volume"),
+			LifecycleState:     core.VolumeLifecycleStateAvailable,
+			SizeInMBs:          common.Int64(102400),
+			AvailabilityDomain: common.String("NWuj:PHX-AD-1"),
+			Id:                 common.String("ocid1.volume.oc1.other"),
+			FreeformTags:       map[string]string{"cluster": "test"},
+		},
+	}
+
+	listed_volume_backups = []core.VolumeBackup{
+		{
+			Id:             common.String("ocid1.volumebackup.oc1.available"),
+			VolumeId:       common.String("ocid1.volume.oc1.available"),
+			LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+			SizeInMBs:      common.Int64(51200),
+			TimeCreated:    &common.SDKTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			Id:             common.String("ocid1.volumebackup.oc1.creating"),
+			VolumeId:       common.String("ocid1.volume.oc1.other"),
+			LifecycleState: core.VolumeBackupLifecycleStateCreating,
+			SizeInMBs:      common.Int64(102400),
+			TimeCreated:    &common.SDKTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			Id:             common.String("ocid1.volumebackup.oc1.terminated"),
+			VolumeId:       common.String("ocid1.volume.oc1.available"),
+			LifecycleState: core.VolumeBackupLifecycleStateTerminated,
+			SizeInMBs:      common.Int64(51200),
+			TimeCreated:    &common.SDKTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+	}
+
+	compartment_volume_attachments = []core.VolumeAttachment{
+		core.IScsiVolumeAttachment{
+			LifecycleState: core.VolumeAttachmentLifecycleStateAttached,
+			Id:             common.String("ocid1.volumeattachment.oc1.available"),
+			InstanceId:     common.String("ocid1.instance.oc1.node1"),
+			VolumeId:       common.String("ocid1.volume.oc1.available"),
+		},
+		core.IScsiVolumeAttachment{
+			LifecycleState: core.VolumeAttachmentLifecycleStateDetaching,
+			Id:             common.String("ocid1.volumeattachment.oc1.detaching"),
+			InstanceId:     common.String("ocid1.instance.oc1.node2"),
+			VolumeId:       common.String("ocid1.volume.oc1.available"),
+		},
 	}
 
 	subnets = map[string]*core.Subnet{
@@ -200,6 +328,31 @@ var (
 	}
 )
 
+type mockServiceError struct {
+	StatusCode int
+	Message    string
+}
+
+func (m mockServiceError) GetHTTPStatusCode() int {
+	return m.StatusCode
+}
+
+func (m mockServiceError) GetMessage() string {
+	return m.Message
+}
+
+func (m mockServiceError) GetCode() string {
+	return ""
+}
+
+func (m mockServiceError) GetOpcRequestID() string {
+	return ""
+}
+
+func (m mockServiceError) Error() string {
+	return m.Message
+}
+
 type MockOCIClient struct{}
 
 func (MockOCIClient) Compute() client.ComputeInterface {
@@ -226,8 +379,21 @@ func (MockOCIClient) Identity(ociClientConfig *client.OCIClientConfig) client.Id
 	return &MockIdentityClient{}
 }
 
+func (MockOCIClient) Limits() client.LimitsInterface {
+	return nil
+}
+
 type MockBlockStorageClient struct {
 	bs util.MockOCIBlockStorageClient
+	// region of the client, empty for testRegion
+	region string
+}
+
+func (c *MockBlockStorageClient) regionName() string {
+	if c.region == "" {
+		return testRegion
+	}
+	return c.region
 }
 
 func (c *MockBlockStorageClient) GetBootVolume(ctx context.Context, id string) (*core.BootVolume, error) {
@@ -277,21 +443,73 @@ func (c *MockBlockStorageClient) CreateVolumeBackup(ctx context.Context, details
 }
 
 func (c *MockBlockStorageClient) DeleteVolumeBackup(ctx context.Context, id string) error {
+	deleted_volume_backups[id] = true
 	return nil
 }
 
 func (c *MockBlockStorageClient) GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error) {
+	if id == "not-found-volume-backup" {
+		return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+	}
+	for region, backups := range regional_volume_backups {
+		for _, backup := range backups {
+			if *backup.Id != id {
+				continue
+			}
+			if region != c.regionName() {
+				return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+			}
+			return &backup, nil
+		}
+	}
+	if c.region != "" {
+		return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+	}
+	if backup, ok := group_volume_backups[id]; ok {
+		return &backup, nil
+	}
+	for _, backup := range listed_volume_backups {
+		if *backup.Id == id {
+			return &backup, nil
+		}
+	}
 	return &core.VolumeBackup{
 		Id: &id,
 	}, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	var backups []core.VolumeBackup
+	for _, backup := range listed_volume_backups {
+		if volumeID == "" || *backup.VolumeId == volumeID {
+			backups = append(backups, backup)
+		}
+	}
+	start := 0
+	if page != "" {
+		var err error
+		if start, err = strconv.Atoi(page); err != nil || start > len(backups) {
+			return nil, "", errors.WithStack(mockServiceError{StatusCode: http.StatusBadRequest, Message: "invalid page"})
+		}
+	}
+	end := len(backups)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	nextPage := ""
+	if end < len(backups) {
+		nextPage = strconv.Itoa(end)
+	}
+	return backups[start:end], nextPage, nil
+}
+
 func (c *MockBlockStorageClient) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	return []core.VolumeBackup{}, nil
 }
 
 type MockProvisionerClient struct {
-	Storage *MockBlockStorageClient
+	Storage      *MockBlockStorageClient
+	LimitsClient client.LimitsInterface
 }
 
 func (c *MockBlockStorageClient) AwaitVolumeAvailableORTimeout(ctx context.Context, id string) (*core.Volume, error) {
@@ -384,6 +602,32 @@ func (c *MockBlockStorageClient) GetVolumesByName(ctx context.Context, volumeNam
 }
 
 // CreateVolume mocks the BlockStorage CreateVolume implementation
+func (c *MockBlockStorageClient) ListVolumes(ctx context.Context, compartmentID string, limit int, page string) ([]core.Volume, string, error) {
+	// volumes without compartment are listed in every compartment
+	var compartmentVolumes []core.Volume
+	for _, volume := range listed_volumes {
+		if volume.CompartmentId == nil || *volume.CompartmentId == compartmentID {
+			compartmentVolumes = append(compartmentVolumes, volume)
+		}
+	}
+	start := 0
+	if page != "" {
+		var err error
+		if start, err = strconv.Atoi(page); err != nil || start > len(compartmentVolumes) {
+			return nil, "", errors.WithStack(mockServiceError{StatusCode: http.StatusBadRequest, Message: "invalid page"})
+		}
+	}
+	end := len(compartmentVolumes)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	nextPage := ""
+	if end < len(compartmentVolumes) {
+		nextPage = strconv.Itoa(end)
+	}
+	return compartmentVolumes[start:end], nextPage, nil
+}
+
 func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error) {
 	volume := volumes[*details.DisplayName]
 	if volume != nil {
@@ -399,6 +643,7 @@ func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.
 }
 
 func (c *MockBlockStorageClient) UpdateVolume(ctx context.Context, volumeId string, details core.UpdateVolumeDetails) (*core.Volume, error) {
+	updated_volumes[volumeId] = details
 	if volumeId == "valid_volume_id_valid_old_size_fail" {
 		return nil, fmt.Errorf("Update volume failed")
 	} else {
@@ -410,8 +655,156 @@ func (c *MockBlockStorageClient) UpdateVolume(ctx context.Context, volumeId stri
 	}
 }
 
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return c.GetVolumeGroup(ctx, id)
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	id := "ocid1.volumegroup.oc1." + *details.DisplayName
+	volume_groups[id] = &core.VolumeGroup{
+		Id:             &id,
+		DisplayName:    details.DisplayName,
+		LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+		VolumeIds:      details.SourceDetails.(core.VolumeGroupSourceFromVolumesDetails).VolumeIds,
+	}
+	return volume_groups[id], nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	delete(volume_groups, id)
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	if volumeGroup, ok := volume_groups[id]; ok {
+		return volumeGroup, nil
+	}
+	return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	var volumeGroups []core.VolumeGroup
+	for _, volumeGroup := range volume_groups {
+		if *volumeGroup.DisplayName == volumeGroupName {
+			volumeGroups = append(volumeGroups, *volumeGroup)
+		}
+	}
+	return volumeGroups, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	id := "ocid1.volumegroupbackup.oc1." + *details.DisplayName
+	volume_group_backups[id] = &core.VolumeGroupBackup{
+		Id:             &id,
+		DisplayName:    details.DisplayName,
+		LifecycleState: core.VolumeGroupBackupLifecycleStateRequestReceived,
+		VolumeGroupId:  details.VolumeGroupId,
+	}
+	return volume_group_backups[id], nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	delete(volume_group_backups, id)
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if volumeGroupBackup, ok := volume_group_backups[id]; ok {
+		return volumeGroupBackup, nil
+	}
+	return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, volumeGroupBackupName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	var volumeGroupBackups []core.VolumeGroupBackup
+	for _, volumeGroupBackup := range volume_group_backups {
+		if *volumeGroupBackup.DisplayName == volumeGroupBackupName {
+			volumeGroupBackups = append(volumeGroupBackups, *volumeGroupBackup)
+		}
+	}
+	return volumeGroupBackups, nil
+}
+
+func (c *MockBlockStorageClient) UpdateVolumeKmsKey(ctx context.Context, volumeId, kmsKeyID string) error {
+	updated_volume_kms_keys[volumeId] = kmsKeyID
+	return nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, volumeBackupID, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	backupCopy := core.VolumeBackup{
+		Id:                   common.String("ocid1.volumebackup.oc1." + destinationRegion + ".copy"),
+		DisplayName:          &displayName,
+		CompartmentId:        common.String("compartment"),
+		SourceVolumeBackupId: &volumeBackupID,
+		LifecycleState:       core.VolumeBackupLifecycleStateCreating,
+		TimeCreated:          &common.SDKTime{Time: time.Now()},
+	}
+	regional_volume_backups[destinationRegion] = append(regional_volume_backups[destinationRegion], backupCopy)
+	return &backupCopy, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupCopies(ctx context.Context, sourceVolumeBackupID, compartmentID string) ([]core.VolumeBackup, error) {
+	var backupCopies []core.VolumeBackup
+	for _, backup := range regional_volume_backups[c.regionName()] {
+		if backup.SourceVolumeBackupId != nil && *backup.SourceVolumeBackupId == sourceVolumeBackupID {
+			backupCopies = append(backupCopies, backup)
+		}
+	}
+	return backupCopies, nil
+}
+
+func (c *MockBlockStorageClient) ForRegion(region string) (client.BlockStorageInterface, error) {
+	return &MockBlockStorageClient{region: region}, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyByName(ctx context.Context, policyName, compartmentID string) (*core.VolumeBackupPolicy, error) {
+	if compartmentID != "" || (policyName != "bronze" && policyName != "silver" && policyName != "gold") {
+		return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+	}
+	return &core.VolumeBackupPolicy{
+		Id:          common.String("ocid1.volumebackuppolicy.oc1." + policyName),
+		DisplayName: &policyName,
+	}, nil
+}
+
+func (c *MockBlockStorageClient) AssignVolumeBackupPolicy(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	assignment := core.VolumeBackupPolicyAssignment{
+		Id:       common.String("ocid1.volumebackuppolicyassign.oc1." + volumeID),
+		AssetId:  &volumeID,
+		PolicyId: &policyID,
+	}
+	volume_backup_policy_assignments[volumeID] = append(volume_backup_policy_assignments[volumeID], assignment)
+	return &assignment, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return volume_backup_policy_assignments[volumeID], nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	for volumeID, assignments := range volume_backup_policy_assignments {
+		for i, assignment := range assignments {
+			if *assignment.Id == id {
+				volume_backup_policy_assignments[volumeID] = append(assignments[:i], assignments[i+1:]...)
+				return nil
+			}
+		}
+	}
+	return errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+}
+
+func (c *MockBlockStorageClient) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	for _, replica := range regional_block_volume_replicas[c.regionName()] {
+		if *replica.Id == id {
+			return &replica, nil
+		}
+	}
+	return nil, errors.WithStack(mockServiceError{StatusCode: http.StatusNotFound, Message: "not found"})
+}
+
 // DeleteVolume mocks the BlockStorage DeleteVolume implementation
 func (c *MockBlockStorageClient) DeleteVolume(ctx context.Context, id string) error {
+	deleted_volumes[id] = true
 	return nil
 }
 
@@ -626,6 +1019,10 @@ type MockComputeClient struct {
 	compute util.MockOCIComputeClient
 }
 
+func (c *MockComputeClient) ListVnicAttachmentsByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.VnicAttachment, error) {
+	return nil, nil
+}
+
 func (c *MockComputeClient) ListInstancesByCompartmentAndAD(ctx context.Context, compartmentId, availabilityDomain string) (response []core.Instance, err error) {
 	return nil, nil
 }
@@ -706,6 +1103,9 @@ func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartme
 		attachments     []core.VolumeAttachment
 		requestMetadata common.RequestMetadata
 	)
+	if volumeID == "" {
+		return compartment_volume_attachments, nil
+	}
 	if volumeID == "find-active-volume-attachment-timeout-volume" {
 		for {
 			resp, err := c.compute.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
@@ -739,11 +1139,11 @@ func (c *MockComputeClient) ListVolumeAttachments(ctx context.Context, compartme
 	return attachments, nil
 }
 
-func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool, isReadOnly bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable, isReadOnly, useChap bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -844,6 +1244,10 @@ func (p *MockProvisionerClient) Identity(ociClientConfig *client.OCIClientConfig
 	return &MockIdentityClient{}
 }
 
+func (p *MockProvisionerClient) Limits() client.LimitsInterface {
+	return p.LimitsClient
+}
+
 func NewClientProvisioner(pcData client.Interface, storageBlock *MockBlockStorageClient, storageFile *MockFileStorageClient) client.Interface {
 	if storageFile == nil {
 		return &MockProvisionerClient{Storage: storageBlock}
@@ -914,7 +1318,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 			wantErr: errors.New("VolumeCapabilities must be provided in CreateVolumeRequest"),
 		},
 		{
-			name:   "Error for unsupported VolumeCapabilities: MULTI_NODE_READER_ONLY with Mount provided in CreateVolumeRequest",
+			name:   "Error for unsupported VolumeCapabilities: SINGLE_NODE_READER_ONLY with Mount provided in CreateVolumeRequest",
 			fields: fields{},
 			args: args{
 				ctx: nil,
@@ -922,7 +1326,7 @@ func TestControllerDriver_CreateVolume(t *testing.T) {
 					Name: "ut-volume",
 					VolumeCapabilities: []*csi.VolumeCapability{{
 						AccessMode: &csi.VolumeCapability_AccessMode{
-							Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY,
+							Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
 						},
 						AccessType: &csi.VolumeCapability_Mount{
 							Mount: &csi.VolumeCapability_MountVolume{},
@@ -1314,6 +1718,121 @@ func TestControllerDriver_DeleteVolume(t *testing.T) {
 	}
 }
 
+func TestBlockVolumeControllerDriver_cleanupVolumeBackupPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		retention   string
+		assigned    bool
+		wantDeleted []string
+	}{
+		{
+			name: "volume without backup policy",
+		},
+		{
+			name:      "scheduled backups are retained",
+			retention: backupRetentionRetain,
+			assigned:  true,
+		},
+		{
+			name:        "scheduled backups are deleted",
+			retention:   backupRetentionDelete,
+			assigned:    true,
+			wantDeleted: []string{"ocid1.volumebackup.oc1.scheduled"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volumeID := "ocid1.volume.oc1.backuppolicy"
+			volumes[volumeID] = &core.Volume{
+				Id:             &volumeID,
+				CompartmentId:  common.String("compartment"),
+				LifecycleState: core.VolumeLifecycleStateAvailable,
+				FreeformTags:   map[string]string{backupRetentionTag: tt.retention},
+			}
+			defer delete(volumes, volumeID)
+			defer func(backups []core.VolumeBackup) { listed_volume_backups = backups }(listed_volume_backups)
+			listed_volume_backups = []core.VolumeBackup{
+				{
+					Id:             common.String("ocid1.volumebackup.oc1.scheduled"),
+					VolumeId:       &volumeID,
+					SourceType:     core.VolumeBackupSourceTypeScheduled,
+					LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+				},
+				{
+					Id:             common.String("ocid1.volumebackup.oc1.manual"),
+					VolumeId:       &volumeID,
+					SourceType:     core.VolumeBackupSourceTypeManual,
+					LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+				},
+			}
+			volume_backup_policy_assignments = map[string][]core.VolumeBackupPolicyAssignment{}
+			deleted_volume_backups = map[string]bool{}
+
+			d := newGroupControllerDriver()
+			if tt.assigned {
+				if err := assignVolumeBackupPolicy(context.Background(), zap.S(), d.client, volumeID, "ocid1.volumebackuppolicy.oc1.gold"); err != nil {
+					t.Fatalf("assignVolumeBackupPolicy() error = %v", err)
+				}
+				if err := assignVolumeBackupPolicy(context.Background(), zap.S(), d.client, volumeID, "ocid1.volumebackuppolicy.oc1.gold"); err != nil {
+					t.Fatalf("assignVolumeBackupPolicy() error = %v", err)
+				}
+				if got := len(volume_backup_policy_assignments[volumeID]); got != 1 {
+					t.Fatalf("assignVolumeBackupPolicy() assigned %d policies, want 1", got)
+				}
+			}
+
+			if err := d.cleanupVolumeBackupPolicy(context.Background(), zap.S(), volumeID); err != nil {
+				t.Fatalf("cleanupVolumeBackupPolicy() error = %v", err)
+			}
+			if got := len(volume_backup_policy_assignments[volumeID]); got != 0 {
+				t.Errorf("cleanupVolumeBackupPolicy() left %d volume backup policy assignments", got)
+			}
+			var deleted []string
+			for id := range deleted_volume_backups {
+				deleted = append(deleted, id)
+			}
+			if !reflect.DeepEqual(deleted, tt.wantDeleted) {
+				t.Errorf("cleanupVolumeBackupPolicy() deleted %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestGetVolumeBackupPolicyID(t *testing.T) {
+	tests := []struct {
+		backupPolicy string
+		want         string
+		wantErr      bool
+	}{
+		{backupPolicy: "silver", want: "ocid1.volumebackuppolicy.oc1.silver"},
+		{backupPolicy: "ocid1.volumebackuppolicy.oc1.custom", want: "ocid1.volumebackuppolicy.oc1.custom"},
+		{backupPolicy: "platinum", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.backupPolicy, func(t *testing.T) {
+			got, err := getVolumeBackupPolicyID(context.Background(), NewClientProvisioner(nil, &MockBlockStorageClient{}, nil), tt.backupPolicy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getVolumeBackupPolicyID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("getVolumeBackupPolicyID() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithBackupRetentionTag(t *testing.T) {
+	bvTags := &providercfg.TagConfig{FreeformTags: map[string]string{"team": "storage"}}
+	got := withBackupRetentionTag(bvTags, "")
+	want := map[string]string{"team": "storage", backupRetentionTag: backupRetentionRetain}
+	if !reflect.DeepEqual(got.FreeformTags, want) {
+		t.Errorf("withBackupRetentionTag() = %v, want %v", got.FreeformTags, want)
+	}
+	if _, ok := bvTags.FreeformTags[backupRetentionTag]; ok {
+		t.Errorf("withBackupRetentionTag() modified the tags %v", bvTags.FreeformTags)
+	}
+}
+
 func TestControllerDriver_ControllerPublishVolume(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -1394,6 +1913,22 @@ func TestControllerDriver_ControllerPublishVolume(t *testing.T) {
 			wantErr: errors.New("Failed to attach volume to node. " +
 				"The volume already has a non-shareable attachment."),
 		},
+		{
+			name: "read-only, but the volume has read/write attachments",
+			args: args{
+				req: &csi.ControllerPublishVolumeRequest{
+					VolumeId: "shareable-volume-with-read-write-attachments",
+					NodeId:   "sample-provider-id",
+					VolumeCapability: &csi.VolumeCapability{
+						AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}},
+						AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY},
+					},
+				},
+			},
+			want: nil,
+			wantErr: errors.New("Failed to attach volume to node. " +
+				"The volume already has attachments which are not read-only, or read-only attachments only."),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1424,6 +1959,41 @@ func TestControllerDriver_ControllerPublishVolume(t *testing.T) {
 	}
 }
 
+func TestControllerDriver_validateCapabilities(t *testing.T) {
+	tests := []struct {
+		name    string
+		block   bool
+		mode    csi.VolumeCapability_AccessMode_Mode
+		wantErr bool
+	}{
+		{name: "mount SINGLE_NODE_WRITER", mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER},
+		{name: "mount SINGLE_NODE_SINGLE_WRITER", mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER},
+		{name: "mount SINGLE_NODE_MULTI_WRITER", mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_MULTI_WRITER},
+		{name: "mount MULTI_NODE_READER_ONLY", mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY},
+		{name: "mount MULTI_NODE_MULTI_WRITER", mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER, wantErr: true},
+		{name: "block SINGLE_NODE_SINGLE_WRITER", block: true, mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER},
+		{name: "block SINGLE_NODE_MULTI_WRITER", block: true, mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_MULTI_WRITER},
+		{name: "block MULTI_NODE_READER_ONLY", block: true, mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY},
+		{name: "block MULTI_NODE_SINGLE_WRITER", block: true, mode: csi.VolumeCapability_AccessMode_MULTI_NODE_SINGLE_WRITER, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{logger: zap.S()}}
+			capability := &csi.VolumeCapability{
+				AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}},
+				AccessMode: &csi.VolumeCapability_AccessMode{Mode: tt.mode},
+			}
+			if tt.block {
+				capability.AccessType = &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}
+			}
+			err := d.validateCapabilities([]*csi.VolumeCapability{capability}, supportedVolumeCapabilities)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCapabilities() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestControllerDriver_ControllerUnpublishVolume(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -1730,6 +2300,30 @@ func TestExtractVolumeParameters(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"StorageClass with replica availability domains": {
+			storageParameters: map[string]string{
+				replicaAvailabilityDomainsKey: "zkJl:US-ASHBURN-AD-2, zkJl.PHX-AD-1,",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:          "",
+				attachmentParameter:        make(map[string]string),
+				vpusPerGB:                  10,
+				replicaAvailabilityDomains: []string{"zkJl:US-ASHBURN-AD-2", "zkJl:PHX-AD-1"},
+			},
+			wantErr: false,
+		},
+		"StorageClass with backup source region": {
+			storageParameters: map[string]string{
+				backupSourceRegion: " us-phoenix-1 ",
+			},
+			volumeParameters: VolumeParameters{
+				diskEncryptionKey:   "",
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupSourceRegion:  "us-phoenix-1",
+			},
+			wantErr: false,
+		},
 		"StorageClass with CMEK and attachment type paravirtualized": {
 			storageParameters: map[string]string{
 				attachmentType: attachmentTypeParavirtualized,
@@ -1910,34 +2504,132 @@ func TestExtractVolumeParameters(t *testing.T) {
 			},
 			wantErr: true,
 		},
-	}
-
-	for name, tt := range tests {
-		t.Run(name, func(t *testing.T) {
-			volumeParameters, err := extractVolumeParameters(zap.S(), tt.storageParameters)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("extractVolumeParameters() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(volumeParameters, tt.volumeParameters) {
-				t.Errorf("extractStorage() = %+v, want %+v", volumeParameters, tt.volumeParameters)
-			}
-		})
-	}
-}
-
-func TestExtractSnapshotParameters(t *testing.T) {
-	tests := map[string]struct {
-		inputParameters    map[string]string
-		snapshotParameters SnapshotParameters
-		wantErr            bool
-	}{
-		"Wrong Backup Type": {
-			inputParameters: map[string]string{
-				backupType: "foo",
+		"if Oracle-defined backup policy then backupPolicy should be its name": {
+			storageParameters: map[string]string{
+				backupPolicyKey:    "Gold",
+				backupRetentionKey: "Delete",
 			},
-			snapshotParameters: SnapshotParameters{
-				backupType: core.CreateVolumeBackupDetailsTypeIncremental,
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "gold",
+				backupRetention:     backupRetentionDelete,
+			},
+		},
+		"if custom backup policy then backupPolicy should be its OCID": {
+			storageParameters: map[string]string{
+				backupPolicyKey: "ocid1.volumebackuppolicy.oc1.custom",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupPolicy:        "ocid1.volumebackuppolicy.oc1.custom",
+			},
+		},
+		"if unknown backup policy then return error": {
+			storageParameters: map[string]string{
+				backupPolicyKey: "platinum",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"if backup retention without backup policy then return error": {
+			storageParameters: map[string]string{
+				backupRetentionKey: backupRetentionDelete,
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				backupRetention:     backupRetentionDelete,
+			},
+			wantErr: true,
+		},
+		"if useChap is true then useChap should be true": {
+			storageParameters: map[string]string{
+				attachmentType: attachmentTypeISCSI,
+				useChapKey:     "true",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: map[string]string{attachmentType: attachmentTypeISCSI},
+				vpusPerGB:           10,
+				useChap:             true,
+			},
+		},
+		"if useChap is invalid then return error": {
+			storageParameters: map[string]string{
+				useChapKey: "yes please",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+			},
+			wantErr: true,
+		},
+		"if luksEncryption is true then luksEncryption should be true": {
+			storageParameters: map[string]string{
+				luksEncryptionKey: "true",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				luksEncryption:      true,
+			},
+		},
+		"if mkfsOptions are provided then they should be normalized": {
+			storageParameters: map[string]string{
+				mkfsOptionsKey:         "  -m reflink=1   -i size=512 ",
+				defaultMountOptionsKey: "noatime,discard",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: make(map[string]string),
+				vpusPerGB:           10,
+				mkfsOptions:         "-m reflink=1 -i size=512",
+				defaultMountOptions: "noatime,discard",
+			},
+		},
+		"if useChap with paravirtualized attachment then return error": {
+			storageParameters: map[string]string{
+				attachmentType: attachmentTypeParavirtualized,
+				useChapKey:     "true",
+			},
+			volumeParameters: VolumeParameters{
+				attachmentParameter: map[string]string{attachmentType: attachmentTypeParavirtualized},
+				vpusPerGB:           10,
+				useChap:             true,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			volumeParameters, err := extractVolumeParameters(zap.S(), tt.storageParameters)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("extractVolumeParameters() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(volumeParameters, tt.volumeParameters) {
+				t.Errorf("extractStorage() = %+v, want %+v", volumeParameters, tt.volumeParameters)
+			}
+		})
+	}
+}
+
+func TestExtractSnapshotParameters(t *testing.T) {
+	tests := map[string]struct {
+		inputParameters    map[string]string
+		snapshotParameters SnapshotParameters
+		wantErr            bool
+	}{
+		"Wrong Backup Type": {
+			inputParameters: map[string]string{
+				backupType: "foo",
+			},
+			snapshotParameters: SnapshotParameters{
+				backupType: core.CreateVolumeBackupDetailsTypeIncremental,
 			},
 			wantErr: true,
 		},
@@ -2009,6 +2701,16 @@ func TestExtractSnapshotParameters(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"With backup copy regions": {
+			inputParameters: map[string]string{
+				backupCopyRegions: " us-phoenix-1, ,eu-frankfurt-1",
+			},
+			snapshotParameters: SnapshotParameters{
+				backupType:  core.CreateVolumeBackupDetailsTypeIncremental,
+				copyRegions: []string{"us-phoenix-1", "eu-frankfurt-1"},
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -2137,6 +2839,7 @@ func TestControllerDriver_DeleteSnapshot(t *testing.T) {
 func TestGetAttachmentOptions(t *testing.T) {
 	tests := map[string]struct {
 		attachmentType         string
+		useChap                bool
 		instanceID             string
 		isShareable            bool
 		volumeAttachmentOption VolumeAttachmentOption
@@ -2152,6 +2855,7 @@ func TestGetAttachmentOptions(t *testing.T) {
 				isShareable:                  false,
 				enforceLimit:                 true,
 				maxVolumeAttachments:         1,
+				attachmentTypes:              []string{attachmentTypeParavirtualized},
 			},
 			wantErr: false,
 		},
@@ -2166,6 +2870,7 @@ func TestGetAttachmentOptions(t *testing.T) {
 				isShareable:                  false,
 				enforceLimit:                 true,
 				maxVolumeAttachments:         1,
+				attachmentTypes:              []string{attachmentTypeParavirtualized},
 			},
 			wantErr: false,
 		},
@@ -2179,6 +2884,7 @@ func TestGetAttachmentOptions(t *testing.T) {
 				isShareable:                  false,
 				enforceLimit:                 true,
 				maxVolumeAttachments:         1,
+				attachmentTypes:              []string{attachmentTypeISCSI},
 			},
 			wantErr: false,
 		},
@@ -2192,6 +2898,7 @@ func TestGetAttachmentOptions(t *testing.T) {
 				isShareable:                  false,
 				enforceLimit:                 true,
 				maxVolumeAttachments:         1,
+				attachmentTypes:              []string{attachmentTypeISCSI},
 			},
 			wantErr: false,
 		},
@@ -2205,9 +2912,21 @@ func TestGetAttachmentOptions(t *testing.T) {
 				isShareable:                  true,
 				enforceLimit:                 false,
 				maxVolumeAttachments:         32,
+				attachmentTypes:              []string{attachmentTypeISCSI},
 			},
 			wantErr: false,
 		},
+		"CHAP with instance in-transit encryption enabled": {
+			useChap:        true,
+			instanceID:     "inTransitEnabled",
+			isShareable:    false,
+			volumeAttachmentOption: VolumeAttachmentOption{
+				enableInTransitEncryption: true,
+				enforceLimit:              true,
+				maxVolumeAttachments:      1,
+			},
+			wantErr: true,
+		},
 		"API error": {
 			attachmentType: attachmentTypeISCSI,
 			instanceID:     "foo",
@@ -2225,7 +2944,7 @@ func TestGetAttachmentOptions(t *testing.T) {
 	for name, tt := range tests {
 
 		t.Run(name, func(t *testing.T) {
-			volumeAttachmentOption, err := getAttachmentOptions(context.Background(), computeClient, tt.attachmentType, tt.instanceID, tt.isShareable)
+			volumeAttachmentOption, err := getAttachmentOptions(context.Background(), computeClient, attachmentPolicy{attachmentType: tt.attachmentType, useChap: tt.useChap}, tt.instanceID, tt.isShareable)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("getAttachmentOptions() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2237,6 +2956,116 @@ func TestGetAttachmentOptions(t *testing.T) {
 	}
 }
 
+func TestAddPerformanceTier(t *testing.T) {
+	volumeID := "ocid1.volume.oc1.publishtier"
+	volumes[volumeID] = &core.Volume{
+		Id:                 common.String(volumeID),
+		VpusPerGB:          common.Int64(20),
+		AutoTunedVpusPerGB: common.Int64(30),
+		AutotunePolicies: []core.AutotunePolicy{
+			core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(40)},
+		},
+	}
+	defer delete(volumes, volumeID)
+
+	d := &BlockVolumeControllerDriver{ControllerDriver{
+		logger: zap.S(),
+		client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+	}}
+	tests := []struct {
+		name     string
+		volumeID string
+		want     map[string]string
+	}{
+		{
+			name:     "modified and autotuned volume",
+			volumeID: volumeID,
+			want: map[string]string{
+				attachmentType:        attachmentTypeISCSI,
+				csi_util.VpusPerGB:    "20",
+				autoTunedVpusPerGBKey: "30",
+				detachedAutotuneKey:   "false",
+				maxVpusPerGBKey:       "40",
+			},
+		},
+		{
+			name:     "volume not found",
+			volumeID: "ocid1.volume.oc1.unknown",
+			want: map[string]string{
+				attachmentType:     attachmentTypeISCSI,
+				csi_util.VpusPerGB: "10",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publishContext := map[string]string{attachmentType: attachmentTypeISCSI, csi_util.VpusPerGB: "10"}
+			d.addPerformanceTier(context.Background(), zap.S(), tt.volumeID, publishContext)
+			if !reflect.DeepEqual(publishContext, tt.want) {
+				t.Errorf("addPerformanceTier() publish context = %v, want %v", publishContext, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneratePublishContextCHAP(t *testing.T) {
+	attachment := func(chapUsername, chapSecret *string) core.IScsiVolumeAttachment {
+		return core.IScsiVolumeAttachment{
+			Id:           common.String("ocid1.volumeattachment.oc1.chap"),
+			Iqn:          common.String("iqn.2015-12.com.oracleiaas:chap"),
+			Ipv4:         common.String("169.254.2.2"),
+			Port:         common.Int(3260),
+			ChapUsername: chapUsername,
+			ChapSecret:   chapSecret,
+		}
+	}
+
+	tests := map[string]struct {
+		useChap    bool
+		attachment core.IScsiVolumeAttachment
+		want       map[string]string
+		wantErr    bool
+	}{
+		"CHAP attachment": {
+			useChap:    true,
+			attachment: attachment(common.String("ocid1.volume.oc1.chap"), common.String("secret")),
+			want: map[string]string{
+				disk.ISCSICHAPUSERNAME: "ocid1.volume.oc1.chap",
+				disk.ISCSICHAPSECRET:   "secret",
+			},
+		},
+		"CHAP attachment without credentials": {
+			useChap:    true,
+			attachment: attachment(nil, nil),
+			wantErr:    true,
+		},
+		"attachment without CHAP": {
+			attachment: attachment(nil, nil),
+			want:       map[string]string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := generatePublishContext(VolumeAttachmentOption{useChap: tt.useChap}, zap.S(), tt.attachment, "10", "", "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generatePublishContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := map[string]string{}
+			for _, key := range []string{disk.ISCSICHAPUSERNAME, disk.ISCSICHAPSECRET} {
+				if v, ok := resp.PublishContext[key]; ok {
+					got[key] = v
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("generatePublishContext() CHAP credentials = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetBVTags(t *testing.T) {
 	emptyTags := &providercfg.InitialTags{}
 	emptyTagConfig := &providercfg.TagConfig{}
@@ -2482,3 +3311,681 @@ func TestClient_GetAvailabilityDomainByName(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerDriver_ListVolumes(t *testing.T) {
+	availableEntry := &csi.ListVolumesResponse_Entry{
+		Volume: &csi.Volume{
+			VolumeId:      "ocid1.volume.oc1.available",
+			CapacityBytes: 50 * client.GiB,
+			AccessibleTopology: []*csi.Topology{
+				{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-2"}},
+				{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-2"}},
+			},
+		},
+		Status: &csi.ListVolumesResponse_VolumeStatus{
+			PublishedNodeIds: []string{"node1"},
+		},
+	}
+	otherEntry := &csi.ListVolumesResponse_Entry{
+		Volume: &csi.Volume{
+			VolumeId:      "ocid1.volume.oc1.other",
+			CapacityBytes: 100 * client.GiB,
+			AccessibleTopology: []*csi.Topology{
+				{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-1"}},
+				{Segments: map[string]string{kubeAPI.LabelZoneFailureDomain: "PHX-AD-1"}},
+			},
+		},
+		Status: &csi.ListVolumesResponse_VolumeStatus{},
+	}
+	tests := []struct {
+		name     string
+		config   *providercfg.BlockVolumeConfig
+		req      *csi.ListVolumesRequest
+		want     *csi.ListVolumesResponse
+		wantCode codes.Code
+	}{
+		{
+			name:     "disabled",
+			req:      &csi.ListVolumesRequest{},
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name:   "volume name prefix",
+			config: &providercfg.BlockVolumeConfig{ListVolumes: true},
+			req:    &csi.ListVolumesRequest{},
+			want: &csi.ListVolumesResponse{
+				Entries: []*csi.ListVolumesResponse_Entry{availableEntry},
+			},
+		},
+		{
+			name:   "freeform tags",
+			config: &providercfg.BlockVolumeConfig{ListVolumes: true, FreeformTags: map[string]string{"cluster": "test"}},
+			req:    &csi.ListVolumesRequest{},
+			want: &csi.ListVolumesResponse{
+				Entries: []*csi.ListVolumesResponse_Entry{availableEntry, otherEntry},
+			},
+		},
+		{
+			name:   "first page",
+			config: &providercfg.BlockVolumeConfig{ListVolumes: true, FreeformTags: map[string]string{"cluster": "test"}},
+			req:    &csi.ListVolumesRequest{MaxEntries: 2},
+			want: &csi.ListVolumesResponse{
+				Entries:   []*csi.ListVolumesResponse_Entry{availableEntry},
+				NextToken: "2",
+			},
+		},
+		{
+			name:   "last page",
+			config: &providercfg.BlockVolumeConfig{ListVolumes: true, FreeformTags: map[string]string{"cluster": "test"}},
+			req:    &csi.ListVolumesRequest{MaxEntries: 2, StartingToken: "2"},
+			want: &csi.ListVolumesResponse{
+				Entries: []*csi.ListVolumesResponse_Entry{otherEntry},
+			},
+		},
+		{
+			name:     "invalid starting token",
+			config:   &providercfg.BlockVolumeConfig{ListVolumes: true},
+			req:      &csi.ListVolumesRequest{StartingToken: "invalid"},
+			wantCode: codes.Aborted,
+		},
+		{
+			name:     "invalid max entries",
+			config:   &providercfg.BlockVolumeConfig{ListVolumes: true},
+			req:      &csi.ListVolumesRequest{MaxEntries: -1},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: fake.NewSimpleClientset(
+					&kubeAPI.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+						Spec:       kubeAPI.NodeSpec{ProviderID: "oci://ocid1.instance.oc1.node1"},
+					},
+					&kubeAPI.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+						Spec:       kubeAPI.NodeSpec{ProviderID: "ocid1.instance.oc1.node2"},
+					},
+				),
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "", BlockVolume: tt.config},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:   &csi_util.Util{Logger: logging.Logger().Sugar()},
+			}}
+			got, err := d.ListVolumes(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ListVolumes() error = %v, want code %v", err, tt.wantCode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListVolumes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerGetCapabilities(t *testing.T) {
+	hasCapability := func(resp *csi.ControllerGetCapabilitiesResponse, capability csi.ControllerServiceCapability_RPC_Type) bool {
+		for _, c := range resp.Capabilities {
+			if c.GetRpc().GetType() == capability {
+				return true
+			}
+		}
+		return false
+	}
+	tests := []struct {
+		name   string
+		config *providercfg.Config
+		want   map[csi.ControllerServiceCapability_RPC_Type]bool
+	}{
+		{
+			name:   "optional capabilities disabled",
+			config: &providercfg.Config{},
+			want: map[csi.ControllerServiceCapability_RPC_Type]bool{
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES:                 false,
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES: false,
+				csi.ControllerServiceCapability_RPC_GET_CAPACITY:                 false,
+				csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS:               true,
+				csi.ControllerServiceCapability_RPC_MODIFY_VOLUME:                true,
+				csi.ControllerServiceCapability_RPC_GET_VOLUME:                   true,
+				csi.ControllerServiceCapability_RPC_VOLUME_CONDITION:             true,
+				csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER:     true,
+			},
+		},
+		{
+			name:   "list volumes enabled",
+			config: &providercfg.Config{BlockVolume: &providercfg.BlockVolumeConfig{ListVolumes: true}},
+			want: map[csi.ControllerServiceCapability_RPC_Type]bool{
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES:                 true,
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES: true,
+				csi.ControllerServiceCapability_RPC_GET_CAPACITY:                 false,
+			},
+		},
+		{
+			name:   "capacity enabled",
+			config: &providercfg.Config{Capacity: &providercfg.CapacityConfig{}},
+			want: map[csi.ControllerServiceCapability_RPC_Type]bool{
+				csi.ControllerServiceCapability_RPC_LIST_VOLUMES: false,
+				csi.ControllerServiceCapability_RPC_GET_CAPACITY: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				config: tt.config,
+			}}
+			resp, err := d.ControllerGetCapabilities(context.Background(), &csi.ControllerGetCapabilitiesRequest{})
+			if err != nil {
+				t.Fatalf("ControllerGetCapabilities() error = %v", err)
+			}
+			for capability, want := range tt.want {
+				if got := hasCapability(resp, capability); got != want {
+					t.Errorf("capability %v advertised = %t, want %t", capability, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ListSnapshots(t *testing.T) {
+	creationTime := timestamppb.New(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	availableEntry := &csi.ListSnapshotsResponse_Entry{
+		Snapshot: &csi.Snapshot{
+			SnapshotId:     "ocid1.volumebackup.oc1.available",
+			SourceVolumeId: "ocid1.volume.oc1.available",
+			SizeBytes:      50 * client.GiB,
+			CreationTime:   creationTime,
+			ReadyToUse:     true,
+		},
+	}
+	creatingEntry := &csi.ListSnapshotsResponse_Entry{
+		Snapshot: &csi.Snapshot{
+			SnapshotId:     "ocid1.volumebackup.oc1.creating",
+			SourceVolumeId: "ocid1.volume.oc1.other",
+			SizeBytes:      100 * client.GiB,
+			CreationTime:   creationTime,
+			ReadyToUse:     false,
+		},
+	}
+	tests := []struct {
+		name     string
+		req      *csi.ListSnapshotsRequest
+		want     *csi.ListSnapshotsResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "all snapshots",
+			req:  &csi.ListSnapshotsRequest{},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{availableEntry, creatingEntry},
+			},
+		},
+		{
+			name: "by snapshot ID",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "ocid1.volumebackup.oc1.creating"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{creatingEntry},
+			},
+		},
+		{
+			name: "by snapshot ID of another source volume",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "ocid1.volumebackup.oc1.creating", SourceVolumeId: "ocid1.volume.oc1.available"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "snapshot ID not found",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "not-found-volume-backup"},
+			want: &csi.ListSnapshotsResponse{},
+		},
+		{
+			name: "by source volume ID",
+			req:  &csi.ListSnapshotsRequest{SourceVolumeId: "ocid1.volume.oc1.available"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{availableEntry},
+			},
+		},
+		{
+			name: "first page",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 1},
+			want: &csi.ListSnapshotsResponse{
+				Entries:   []*csi.ListSnapshotsResponse_Entry{availableEntry},
+				NextToken: "1",
+			},
+		},
+		{
+			name: "last page",
+			req:  &csi.ListSnapshotsRequest{MaxEntries: 2, StartingToken: "1"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{creatingEntry},
+			},
+		},
+		{
+			name:     "invalid starting token",
+			req:      &csi.ListSnapshotsRequest{StartingToken: "invalid"},
+			wantCode: codes.Aborted,
+		},
+		{
+			name: "by snapshot ID of another region",
+			req:  &csi.ListSnapshotsRequest{SnapshotId: "ocid1.volumebackup.oc1.phx.source"},
+			want: &csi.ListSnapshotsResponse{
+				Entries: []*csi.ListSnapshotsResponse_Entry{{
+					Snapshot: &csi.Snapshot{
+						SnapshotId:     "ocid1.volumebackup.oc1.phx.source",
+						SourceVolumeId: "ocid1.volume.oc1.phx.source",
+						SizeBytes:      50 * client.GiB,
+						CreationTime:   creationTime,
+						ReadyToUse:     true,
+					},
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupRegionalVolumeBackups()
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "", Auth: providercfg.AuthConfig{Region: testRegion}},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:   &csi_util.Util{Logger: logging.Logger().Sugar()},
+			}}
+			got, err := d.ListSnapshots(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ListSnapshots() error = %v, want code %v", err, tt.wantCode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerModifyVolume(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *csi.ControllerModifyVolumeRequest
+		wantCode    codes.Code
+		wantDetails *core.UpdateVolumeDetails
+		wantKmsKey  string
+	}{
+		{
+			name:     "missing volume id",
+			req:      &csi.ControllerModifyVolumeRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "immutable parameter",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{attachmentType: attachmentTypeISCSI},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid vpusPerGB",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "130"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unchanged parameters",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId: "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{
+					csi_util.VpusPerGB:          "10",
+					detachedAutotuneKey:         "true",
+					initialFreeformTagsOverride: `{"team": "storage"}`,
+					kmsKey:                      "ocid1.key.oc1.current",
+				},
+			},
+		},
+		{
+			name: "performance and autotune",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId: "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{
+					csi_util.VpusPerGB:  "20",
+					detachedAutotuneKey: "false",
+					maxVpusPerGBKey:     "30",
+				},
+			},
+			wantDetails: &core.UpdateVolumeDetails{
+				DisplayName:      common.String("csi-modify"),
+				VpusPerGB:        common.Int64(20),
+				AutotunePolicies: []core.AutotunePolicy{core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(30)}},
+			},
+		},
+		{
+			name: "maxVpusPerGB below vpusPerGB",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20", maxVpusPerGBKey: "10"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ultra high performance with detached autotune",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "30"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "tags are merged",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId: "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{
+					initialFreeformTagsOverride: `{"env": "prod"}`,
+					initialDefinedTagsOverride:  `{"ns": {"owner": "team"}}`,
+				},
+			},
+			wantDetails: &core.UpdateVolumeDetails{
+				DisplayName:  common.String("csi-modify"),
+				FreeformTags: map[string]string{"team": "storage", "env": "prod"},
+				DefinedTags:  map[string]map[string]interface{}{"ns": {"cost-center": "1", "owner": "team"}},
+			},
+		},
+		{
+			name: "kms key",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.modify",
+				MutableParameters: map[string]string{kmsKey: "ocid1.key.oc1.new"},
+			},
+			wantKmsKey: "ocid1.key.oc1.new",
+		},
+		{
+			name: "ultra high performance of an attached volume",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.attached",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "30"},
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "performance of an attached volume",
+			req: &csi.ControllerModifyVolumeRequest{
+				VolumeId:          "ocid1.volume.oc1.attached",
+				MutableParameters: map[string]string{csi_util.VpusPerGB: "20"},
+			},
+			wantDetails: &core.UpdateVolumeDetails{
+				DisplayName: common.String("csi-attached"),
+				VpusPerGB:   common.Int64(20),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated_volumes = map[string]core.UpdateVolumeDetails{}
+			updated_volume_kms_keys = map[string]string{}
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "compartment"},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+				util:   &csi_util.Util{Logger: logging.Logger().Sugar()},
+			}}
+			_, err := d.ControllerModifyVolume(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ControllerModifyVolume() error = %v, want code %v", err, tt.wantCode)
+			}
+			details, updated := updated_volumes[tt.req.VolumeId]
+			if tt.wantDetails == nil && updated {
+				t.Errorf("ControllerModifyVolume() updated the volume with %+v, want no update", details)
+			}
+			if tt.wantDetails != nil && !reflect.DeepEqual(details, *tt.wantDetails) {
+				t.Errorf("ControllerModifyVolume() updated the volume with %+v, want %+v", details, *tt.wantDetails)
+			}
+			if got := updated_volume_kms_keys[tt.req.VolumeId]; got != tt.wantKmsKey {
+				t.Errorf("ControllerModifyVolume() updated the KMS key to %q, want %q", got, tt.wantKmsKey)
+			}
+		})
+	}
+}
+
+func TestValidateAutotunePolicies(t *testing.T) {
+	tests := []struct {
+		name             string
+		vpusPerGB        int64
+		detachedAutotune bool
+		maxVpusPerGB     int64
+		wantErr          bool
+	}{
+		{name: "no autotune", vpusPerGB: 30},
+		{name: "detached autotune", vpusPerGB: 20, detachedAutotune: true},
+		{name: "performance based autotune", vpusPerGB: 10, maxVpusPerGB: 120},
+		{name: "ultra high performance with performance based autotune", vpusPerGB: 30, maxVpusPerGB: 50},
+		{name: "ultra high performance with detached autotune", vpusPerGB: 30, detachedAutotune: true, wantErr: true},
+		{name: "maxVpusPerGB below vpusPerGB", vpusPerGB: 20, maxVpusPerGB: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAutotunePolicies(tt.vpusPerGB, tt.detachedAutotune, tt.maxVpusPerGB)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAutotunePolicies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_applyPVCAutotuneOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		parameters  map[string]string
+		want        VolumeParameters
+		wantCode    codes.Code
+	}{
+		{
+			name:       "no persistent volume claim metadata",
+			parameters: map[string]string{},
+			want:       VolumeParameters{vpusPerGB: 20, detachedAutotune: true},
+		},
+		{
+			name:       "persistent volume claim not found",
+			parameters: map[string]string{pvcNameKey: "missing", pvcNamespaceKey: "default"},
+			want:       VolumeParameters{vpusPerGB: 20, detachedAutotune: true},
+		},
+		{
+			name:        "annotations override the storage class",
+			annotations: map[string]string{detachedAutotuneAnnotation: "false", maxVpusPerGBAnnotation: "50"},
+			want:        VolumeParameters{vpusPerGB: 20, maxVpusPerGB: 50},
+		},
+		{
+			name:        "invalid detached autotune annotation",
+			annotations: map[string]string{detachedAutotuneAnnotation: "yes please"},
+			wantCode:    codes.InvalidArgument,
+		},
+		{
+			name:        "invalid maxVpusPerGB annotation",
+			annotations: map[string]string{maxVpusPerGBAnnotation: "130"},
+			wantCode:    codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parameters := tt.parameters
+			if parameters == nil {
+				parameters = map[string]string{pvcNameKey: "data", pvcNamespaceKey: "default"}
+			}
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: fake.NewSimpleClientset(&kubeAPI.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default", Annotations: tt.annotations},
+				}),
+				logger: zap.S(),
+			}}
+			got, err := d.applyPVCAutotuneOverrides(context.Background(), zap.S(), parameters, VolumeParameters{vpusPerGB: 20, detachedAutotune: true})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("applyPVCAutotuneOverrides() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyPVCAutotuneOverrides() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// setupRegionalVolumeBackups resets the volume backups by region of the mock
+// block storage client to an available backup of testBackupSourceRegion.
+func setupRegionalVolumeBackups() {
+	regional_volume_backups = map[string][]core.VolumeBackup{
+		testBackupSourceRegion: {
+			{
+				Id:             common.String("ocid1.volumebackup.oc1.phx.source"),
+				DisplayName:    common.String("source"),
+				CompartmentId:  common.String("compartment"),
+				VolumeId:       common.String("ocid1.volume.oc1.phx.source"),
+				LifecycleState: core.VolumeBackupLifecycleStateAvailable,
+				SizeInMBs:      common.Int64(51200),
+				TimeCreated:    &common.SDKTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+			},
+		},
+	}
+}
+
+func TestBlockVolumeControllerDriver_copyVolumeBackup(t *testing.T) {
+	tests := []struct {
+		name           string
+		regions        []string
+		parameters     map[string]string
+		wantAnnotation string
+	}{
+		{
+			name: "no copy regions",
+			parameters: map[string]string{
+				volumeSnapshotContentNameKey: "snapcontent",
+			},
+		},
+		{
+			name:    "without volume snapshot metadata",
+			regions: []string{"eu-frankfurt-1"},
+		},
+		{
+			name:    "copied and new copy regions",
+			regions: []string{testRegion, "eu-frankfurt-1"},
+			parameters: map[string]string{
+				volumeSnapshotNameKey:        "snapshot",
+				volumeSnapshotNamespaceKey:   "default",
+				volumeSnapshotContentNameKey: "snapcontent",
+			},
+			wantAnnotation: `{"eu-frankfurt-1":"ocid1.volumebackup.oc1.eu-frankfurt-1.copy","us-ashburn-1":"ocid1.volumebackup.oc1.us-ashburn-1.copy"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupRegionalVolumeBackups()
+			sourceBlockStorage := &MockBlockStorageClient{region: testBackupSourceRegion}
+			if _, err := sourceBlockStorage.CopyVolumeBackup(context.Background(), "ocid1.volumebackup.oc1.phx.source", testRegion, "source"); err != nil {
+				t.Fatalf("CopyVolumeBackup() error = %v", err)
+			}
+			snapshotClient := snapshotfake.NewSimpleClientset(
+				&snapshotv1.VolumeSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"}},
+				&snapshotv1.VolumeSnapshotContent{ObjectMeta: metav1.ObjectMeta{Name: "snapcontent"}},
+			)
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				SnapshotClient: snapshotClient,
+				logger:         zap.S(),
+				config:         &providercfg.Config{CompartmentID: "compartment"},
+				client:         NewClientProvisioner(nil, sourceBlockStorage, nil),
+			}}
+			volumeBackup := regional_volume_backups[testBackupSourceRegion][0]
+			if err := d.copyVolumeBackup(context.Background(), zap.S(), volumeBackup, tt.regions, tt.parameters); err != nil {
+				t.Fatalf("copyVolumeBackup() error = %v", err)
+			}
+
+			for _, region := range tt.regions {
+				if copies := len(regional_volume_backups[region]); copies != 1 {
+					t.Errorf("copyVolumeBackup() left %d copies in region %s, want 1", copies, region)
+				}
+			}
+			content, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), "snapcontent", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get the volume snapshot content: %v", err)
+			}
+			if got := content.Annotations[backupCopiesAnnotation]; got != tt.wantAnnotation {
+				t.Errorf("copyVolumeBackup() annotated the volume snapshot content with %q, want %q", got, tt.wantAnnotation)
+			}
+			snapshot, err := snapshotClient.SnapshotV1().VolumeSnapshots("default").Get(context.Background(), "snapshot", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get the volume snapshot: %v", err)
+			}
+			if got := snapshot.Annotations[backupCopiesAnnotation]; got != tt.wantAnnotation {
+				t.Errorf("copyVolumeBackup() annotated the volume snapshot with %q, want %q", got, tt.wantAnnotation)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_volumeBackupCopy(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		region     string
+		copyState  core.VolumeBackupLifecycleStateEnum
+		wantCode   codes.Code
+		wantCopies int
+	}{
+		{
+			name:     "unknown region of the cluster",
+			id:       "ocid1.volumebackup.oc1.phx.source",
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "backup not found in the source region",
+			id:       "ocid1.volumebackup.oc1.phx.deleted",
+			region:   testRegion,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:       "backup not copied yet",
+			id:         "ocid1.volumebackup.oc1.phx.source",
+			region:     testRegion,
+			wantCode:   codes.DeadlineExceeded,
+			wantCopies: 1,
+		},
+		{
+			name:       "backup being copied",
+			id:         "ocid1.volumebackup.oc1.phx.source",
+			region:     testRegion,
+			copyState:  core.VolumeBackupLifecycleStateCreating,
+			wantCode:   codes.DeadlineExceeded,
+			wantCopies: 1,
+		},
+		{
+			name:       "backup copied",
+			id:         "ocid1.volumebackup.oc1.phx.source",
+			region:     testRegion,
+			copyState:  core.VolumeBackupLifecycleStateAvailable,
+			wantCopies: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupRegionalVolumeBackups()
+			if tt.copyState != "" {
+				regional_volume_backups[testRegion] = []core.VolumeBackup{{
+					Id:                   common.String("ocid1.volumebackup.oc1.iad.copy"),
+					SourceVolumeBackupId: common.String("ocid1.volumebackup.oc1.phx.source"),
+					LifecycleState:       tt.copyState,
+				}}
+			}
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "compartment", Auth: providercfg.AuthConfig{Region: tt.region}},
+				client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+			got, err := d.volumeBackupCopy(context.Background(), zap.S(), tt.id, testBackupSourceRegion)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("volumeBackupCopy() error = %v, want code %v", err, tt.wantCode)
+			}
+			if copies := len(regional_volume_backups[testRegion]); copies != tt.wantCopies {
+				t.Errorf("volumeBackupCopy() left %d copies, want %d", copies, tt.wantCopies)
+			}
+			if err == nil && *got.Id != "ocid1.volumebackup.oc1.iad.copy" {
+				t.Errorf("volumeBackupCopy() = %s, want ocid1.volumebackup.oc1.iad.copy", *got.Id)
+			}
+		})
+	}
+}
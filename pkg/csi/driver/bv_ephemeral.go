@@ -0,0 +1,456 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+const (
+	// ephemeralVolumeContextKey is the volume context key set to "true" by
+	// the kubelet for CSI ephemeral inline volumes
+	ephemeralVolumeContextKey = "csi.storage.k8s.io/ephemeral"
+	// ephemeralVolumeSizeKey is the volume attribute of the size of ephemeral
+	// volumes
+	ephemeralVolumeSizeKey = "size"
+	// defaultEphemeralVolumeSize is the size of ephemeral volumes without
+	// size attribute
+	defaultEphemeralVolumeSize = "50Gi"
+	// ephemeralVolumeNamePrefix is the prefix of the display names of the
+	// ephemeral volumes, followed by the volume ID of the kubelet
+	ephemeralVolumeNamePrefix = "csi-ephemeral-"
+	// ephemeralVolumeTag is the freeform tag of the ephemeral volumes with the
+	// volume ID of the kubelet
+	ephemeralVolumeTag = "oci-csi-ephemeral-volume"
+	// ephemeralInstanceTag is the freeform tag of the ephemeral volumes with
+	// the OCID of the instance they are created for
+	ephemeralInstanceTag = "oci-csi-ephemeral-instance"
+	// ephemeralVolumeTimeout is the timeout of the creation and attachment,
+	// and of the detachment of ephemeral volumes
+	ephemeralVolumeTimeout = 3 * time.Minute
+)
+
+// kubeletPluginsDir is the directory of the CSI plugin data of the kubelet,
+// the ephemeral volumes are staged in and record their state in its
+// ephemeral directory of the driver
+var kubeletPluginsDir = "/var/lib/kubelet/plugins/kubernetes.io/csi"
+
+// ephemeralVolumeState is the state of an ephemeral volume published on the
+// node, recorded until the volume is deleted.
+type ephemeralVolumeState struct {
+	// VolumeID is the OCID of the block volume
+	VolumeID string `json:"volumeID"`
+	// InstanceID is the OCID of the instance of the node
+	InstanceID string `json:"instanceID"`
+	// CompartmentID is the compartment of the instance and of the volume
+	CompartmentID string `json:"compartmentID"`
+}
+
+// ephemeralVolumeParameters are the volume attributes of ephemeral volumes.
+type ephemeralVolumeParameters struct {
+	sizeInBytes int64
+	vpusPerGB   int64
+}
+
+func extractEphemeralVolumeParameters(volumeContext map[string]string) (ephemeralVolumeParameters, error) {
+	p := ephemeralVolumeParameters{vpusPerGB: 10}
+	size, err := resource.ParseQuantity(defaultEphemeralVolumeSize)
+	if err != nil {
+		return p, err
+	}
+	if v, ok := volumeContext[ephemeralVolumeSizeKey]; ok {
+		if size, err = resource.ParseQuantity(v); err != nil {
+			return p, status.Errorf(codes.InvalidArgument, "invalid %s: %s provided for the ephemeral volume", ephemeralVolumeSizeKey, v)
+		}
+	}
+	p.sizeInBytes = size.Value()
+	if v, ok := volumeContext[csi_util.VpusPerGB]; ok {
+		if p.vpusPerGB, err = csi_util.ExtractBlockVolumePerformanceLevel(v); err != nil {
+			return p, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return p, nil
+}
+
+func ephemeralVolumeDir() string {
+	return filepath.Join(kubeletPluginsDir, BlockVolumeDriverName, "ephemeral")
+}
+
+// ephemeralStagingPath is the staging path of the ephemeral volume with the
+// volume ID of the kubelet.
+func ephemeralStagingPath(volumeID string) string {
+	return filepath.Join(ephemeralVolumeDir(), volumeID)
+}
+
+func ephemeralStatePath(volumeID string) string {
+	return filepath.Join(ephemeralVolumeDir(), volumeID+".json")
+}
+
+// readEphemeralVolumeState returns the state of the ephemeral volume with the
+// volume ID of the kubelet, nil if the volume is not an ephemeral volume
+// published on the node.
+func readEphemeralVolumeState(volumeID string) (*ephemeralVolumeState, error) {
+	data, err := os.ReadFile(ephemeralStatePath(volumeID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	state := &ephemeralVolumeState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "invalid state of ephemeral volume %s", volumeID)
+	}
+	return state, nil
+}
+
+func writeEphemeralVolumeState(volumeID string, state *ephemeralVolumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(ephemeralVolumeDir(), 0750); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(ephemeralStatePath(volumeID), data, 0640))
+}
+
+// publishEphemeralVolume creates a block volume for the ephemeral volume,
+// attaches it to the instance of the node, and then stages and publishes it
+// as a persistent volume.
+func (d BlockVolumeNodeDriver) publishEphemeralVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
+	if req.TargetPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "Target Path must be provided")
+	}
+	if req.VolumeCapability == nil {
+		return nil, status.Error(codes.InvalidArgument, "Volume Capability must be provided")
+	}
+	if d.ephemeralClient == nil {
+		return nil, status.Error(codes.InvalidArgument, "Ephemeral volumes are not enabled on the node, see --ephemeral-volume-config")
+	}
+	logger := d.logger.With("volumeID", req.VolumeId, "targetPath", req.TargetPath, "ephemeral", true)
+	params, err := extractEphemeralVolumeParameters(req.VolumeContext)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Invalid ephemeral volume attributes.")
+		return nil, err
+	}
+
+	if acquired := d.volumeLocks.TryAcquire(req.VolumeId); !acquired {
+		logger.Error("Could not acquire lock for NodePublishVolume.")
+		return nil, status.Errorf(codes.Aborted, volumeOperationAlreadyExistsFmt, req.VolumeId)
+	}
+	defer d.volumeLocks.Release(req.VolumeId)
+
+	ctx, cancel := context.WithTimeout(ctx, ephemeralVolumeTimeout)
+	defer cancel()
+
+	state, err := readEphemeralVolumeState(req.VolumeId)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to read the state of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	instance, err := d.nodeInstance(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to get the instance of the node.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if state == nil {
+		state = &ephemeralVolumeState{InstanceID: *instance.Id, CompartmentID: *instance.CompartmentId}
+	}
+
+	if state.VolumeID == "" {
+		volume, err := d.createEphemeralVolume(ctx, logger, req.VolumeId, instance, params)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("Failed to create the ephemeral volume.")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		state.VolumeID = *volume.Id
+		// the state is recorded before the volume is attached, so that the
+		// volume is deleted by NodeUnpublishVolume whatever happens next
+		if err := writeEphemeralVolumeState(req.VolumeId, state); err != nil {
+			logger.With(zap.Error(err)).Error("Failed to record the state of the ephemeral volume.")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	logger = logger.With("ociVolumeID", state.VolumeID)
+
+	useParavirtualized := instance.LaunchOptions != nil && instance.LaunchOptions.IsPvEncryptionInTransitEnabled != nil &&
+		*instance.LaunchOptions.IsPvEncryptionInTransitEnabled
+	attachment, err := d.ephemeralClient.Compute().FindVolumeAttachment(ctx, state.CompartmentID, state.VolumeID, &state.InstanceID)
+	if err != nil && !client.IsNotFound(err) {
+		logger.With(zap.Error(err)).Error("Failed to find the attachment of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if attachment == nil || client.IsNotFound(err) {
+		if useParavirtualized {
+			attachment, err = d.ephemeralClient.Compute().AttachParavirtualizedVolume(ctx, state.InstanceID, state.VolumeID, true, false, false)
+		} else {
+			attachment, err = d.ephemeralClient.Compute().AttachVolume(ctx, state.InstanceID, state.VolumeID, false, false, false)
+		}
+		if err != nil {
+			logger.With(zap.Error(err)).Error("Failed to attach the ephemeral volume.")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if attachment, err = d.ephemeralClient.Compute().WaitForVolumeAttached(ctx, *attachment.GetId()); err != nil {
+		logger.With(zap.Error(err)).Error("Timed out waiting for the ephemeral volume to be attached.")
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	_, isISCSI := attachment.(core.IScsiVolumeAttachment)
+	useParavirtualized = !isISCSI
+	publishResp, err := generatePublishContext(VolumeAttachmentOption{useParavirtualizedAttachment: useParavirtualized}, logger, attachment,
+		strconv.FormatInt(params.vpusPerGB, 10), "false", "")
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to generate the publish context of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stagingPath := ephemeralStagingPath(req.VolumeId)
+	if err := os.MkdirAll(stagingPath, 0750); err != nil {
+		logger.With(zap.Error(err)).Error("Failed to create the staging path of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if _, err := d.NodeStageVolume(ctx, &csi.NodeStageVolumeRequest{
+		VolumeId:          state.VolumeID,
+		PublishContext:    publishResp.PublishContext,
+		StagingTargetPath: stagingPath,
+		VolumeCapability:  req.VolumeCapability,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := d.NodePublishVolume(ctx, &csi.NodePublishVolumeRequest{
+		VolumeId:          state.VolumeID,
+		PublishContext:    publishResp.PublishContext,
+		StagingTargetPath: stagingPath,
+		TargetPath:        req.TargetPath,
+		VolumeCapability:  req.VolumeCapability,
+		Readonly:          req.Readonly,
+	}); err != nil {
+		return nil, err
+	}
+	logger.Info("Ephemeral volume is published.")
+	return &csi.NodePublishVolumeResponse{}, nil
+}
+
+// createEphemeralVolume returns the available block volume of the ephemeral
+// volume, created in the availability domain of the instance if it does not
+// exist yet.
+func (d BlockVolumeNodeDriver) createEphemeralVolume(ctx context.Context, logger *zap.SugaredLogger, volumeID string, instance *core.Instance,
+	params ephemeralVolumeParameters) (*core.Volume, error) {
+	volumeName := ephemeralVolumeNamePrefix + volumeID
+	volumes, err := d.ephemeralClient.BlockStorage().GetVolumesByName(ctx, volumeName, *instance.CompartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	if len(volumes) > 0 {
+		id = *volumes[0].Id
+	} else {
+		tags := &config.TagConfig{FreeformTags: map[string]string{
+			ephemeralVolumeTag:   volumeID,
+			ephemeralInstanceTag: *instance.Id,
+		}}
+		volume, err := provision(ctx, logger, d.ephemeralClient, volumeName, params.sizeInBytes, *instance.AvailabilityDomain,
+			*instance.CompartmentId, "", "", "", params.vpusPerGB, tags, "", nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		id = *volume.Id
+	}
+	return d.ephemeralClient.BlockStorage().AwaitVolumeAvailableORTimeout(ctx, id)
+}
+
+// unpublishEphemeralVolume unpublishes and unstages the ephemeral volume, and
+// then detaches and deletes its block volume.
+func (d BlockVolumeNodeDriver) unpublishEphemeralVolume(ctx context.Context, req *csi.NodeUnpublishVolumeRequest, state *ephemeralVolumeState) (*csi.NodeUnpublishVolumeResponse, error) {
+	logger := d.logger.With("volumeID", req.VolumeId, "targetPath", req.TargetPath, "ociVolumeID", state.VolumeID, "ephemeral", true)
+	if d.ephemeralClient == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Ephemeral volumes are not enabled on the node, see --ephemeral-volume-config")
+	}
+
+	if acquired := d.volumeLocks.TryAcquire(req.VolumeId); !acquired {
+		logger.Error("Could not acquire lock for NodeUnpublishVolume.")
+		return nil, status.Errorf(codes.Aborted, volumeOperationAlreadyExistsFmt, req.VolumeId)
+	}
+	defer d.volumeLocks.Release(req.VolumeId)
+
+	ctx, cancel := context.WithTimeout(ctx, ephemeralVolumeTimeout)
+	defer cancel()
+
+	if _, err := d.NodeUnpublishVolume(ctx, &csi.NodeUnpublishVolumeRequest{VolumeId: state.VolumeID, TargetPath: req.TargetPath}); err != nil {
+		return nil, err
+	}
+	stagingPath := ephemeralStagingPath(req.VolumeId)
+	if _, err := d.NodeUnstageVolume(ctx, &csi.NodeUnstageVolumeRequest{VolumeId: state.VolumeID, StagingTargetPath: stagingPath}); err != nil {
+		return nil, err
+	}
+
+	attachment, err := d.ephemeralClient.Compute().FindVolumeAttachment(ctx, state.CompartmentID, state.VolumeID, &state.InstanceID)
+	if err != nil && !client.IsNotFound(err) {
+		logger.With(zap.Error(err)).Error("Failed to find the attachment of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if attachment != nil {
+		// attachments being detached are returned with a not found error
+		if !client.IsNotFound(err) {
+			if err := d.ephemeralClient.Compute().DetachVolume(ctx, *attachment.GetId()); err != nil {
+				logger.With(zap.Error(err)).Error("Failed to detach the ephemeral volume.")
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		if err := d.ephemeralClient.Compute().WaitForVolumeDetached(ctx, *attachment.GetId()); err != nil {
+			logger.With(zap.Error(err)).Error("Timed out waiting for the ephemeral volume to be detached.")
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
+	}
+	if err := d.ephemeralClient.BlockStorage().DeleteVolume(ctx, state.VolumeID); err != nil && !client.IsNotFound(err) {
+		logger.With(zap.Error(err)).Error("Failed to delete the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := os.Remove(stagingPath); err != nil && !os.IsNotExist(err) {
+		logger.With(zap.Error(err)).Warn("Failed to remove the staging path of the ephemeral volume.")
+	}
+	if err := os.Remove(ephemeralStatePath(req.VolumeId)); err != nil && !os.IsNotExist(err) {
+		logger.With(zap.Error(err)).Error("Failed to remove the state of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Info("Ephemeral volume is deleted.")
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+// nodeInstance returns the instance of the node from its provider ID.
+func (d BlockVolumeNodeDriver) nodeInstance(ctx context.Context) (*core.Instance, error) {
+	node, err := d.KubeClient.CoreV1().Nodes().Get(ctx, d.nodeID, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get node %s", d.nodeID)
+	}
+	if node.Spec.ProviderID == "" {
+		return nil, errors.Errorf("node %s has no provider ID", d.nodeID)
+	}
+	return d.ephemeralClient.Compute().GetInstance(ctx, client.MapProviderIDToInstanceID(node.Spec.ProviderID))
+}
+
+// runEphemeralVolumeCleanup deletes every period the ephemeral volumes left
+// by the nodes which died before unpublishing them.
+func (d *BlockVolumeControllerDriver) runEphemeralVolumeCleanup(period time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		d.cleanupEphemeralVolumes(context.Background())
+	}, period, stopCh)
+}
+
+// cleanupEphemeralVolumes deletes the available ephemeral volumes of the
+// compartments of the nodes whose instance is terminated, once they are
+// detached.
+func (d *BlockVolumeControllerDriver) cleanupEphemeralVolumes(ctx context.Context) {
+	for _, compartmentID := range d.ephemeralVolumeCompartments(ctx) {
+		d.cleanupCompartmentEphemeralVolumes(ctx, compartmentID)
+	}
+}
+
+// ephemeralVolumeCompartments returns the compartments ephemeral volumes are
+// created in, which are the compartments of the instances of the nodes, and
+// the compartment of the cluster.
+func (d *BlockVolumeControllerDriver) ephemeralVolumeCompartments(ctx context.Context) []string {
+	compartments := map[string]struct{}{}
+	if d.config.CompartmentID != "" {
+		compartments[d.config.CompartmentID] = struct{}{}
+	}
+	if nodes, err := d.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); err != nil {
+		d.logger.With(zap.Error(err)).Error("Failed to list the nodes, cleaning up the ephemeral volumes of the compartment of the cluster only.")
+	} else {
+		for _, node := range nodes.Items {
+			if compartmentID := node.Annotations[util.CompartmentIDAnnotation]; compartmentID != "" {
+				compartments[compartmentID] = struct{}{}
+			}
+		}
+	}
+
+	compartmentIDs := make([]string, 0, len(compartments))
+	for compartmentID := range compartments {
+		compartmentIDs = append(compartmentIDs, compartmentID)
+	}
+	sort.Strings(compartmentIDs)
+	return compartmentIDs
+}
+
+func (d *BlockVolumeControllerDriver) cleanupCompartmentEphemeralVolumes(ctx context.Context, compartmentID string) {
+	page := ""
+	for {
+		volumes, nextPage, err := d.client.BlockStorage().ListVolumes(ctx, compartmentID, 0, page)
+		if err != nil {
+			d.logger.With(zap.Error(err)).With("compartmentID", compartmentID).Error("Failed to list volumes, skipping the clean up of ephemeral volumes of the compartment.")
+			return
+		}
+		for _, volume := range volumes {
+			instanceID, ok := volume.FreeformTags[ephemeralInstanceTag]
+			if !ok || volume.LifecycleState != core.VolumeLifecycleStateAvailable {
+				continue
+			}
+			d.cleanupEphemeralVolume(ctx, volume, instanceID)
+		}
+		if page = nextPage; page == "" {
+			return
+		}
+	}
+}
+
+func (d *BlockVolumeControllerDriver) cleanupEphemeralVolume(ctx context.Context, volume core.Volume, instanceID string) {
+	log := d.logger.With("volumeID", *volume.Id, "instanceID", instanceID, "csiOperation", "ephemeralVolumeCleanup")
+	instance, err := d.client.Compute().GetInstance(ctx, instanceID)
+	if err != nil && !client.IsNotFound(err) {
+		log.With(zap.Error(err)).Error("Failed to get the instance of the ephemeral volume.")
+		return
+	}
+	if err == nil && !client.IsInstanceInTerminalState(instance) {
+		return
+	}
+	attachments, err := d.client.Compute().ListVolumeAttachments(ctx, *volume.CompartmentId, *volume.Id)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list the attachments of the ephemeral volume.")
+		return
+	}
+	if len(attachments) > 0 {
+		// the attachments to terminated instances are detached by OCI
+		log.Info("Ephemeral volume of a terminated instance is still attached, will retry the clean up.")
+		return
+	}
+	if err := d.client.BlockStorage().DeleteVolume(ctx, *volume.Id); err != nil && !client.IsNotFound(err) {
+		log.With(zap.Error(err)).Error("Failed to delete the ephemeral volume of a terminated instance.")
+		return
+	}
+	log.Info("Deleted the ephemeral volume of a terminated instance.")
+}
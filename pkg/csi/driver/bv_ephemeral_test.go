@@ -0,0 +1,143 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+func TestExtractEphemeralVolumeParameters(t *testing.T) {
+	tests := []struct {
+		name          string
+		volumeContext map[string]string
+		want          ephemeralVolumeParameters
+		wantErr       bool
+	}{
+		{
+			name:          "defaults",
+			volumeContext: map[string]string{ephemeralVolumeContextKey: "true"},
+			want:          ephemeralVolumeParameters{sizeInBytes: 50 * 1024 * 1024 * 1024, vpusPerGB: 10},
+		},
+		{
+			name:          "size and performance level",
+			volumeContext: map[string]string{ephemeralVolumeSizeKey: "100Gi", "vpusPerGB": "20"},
+			want:          ephemeralVolumeParameters{sizeInBytes: 100 * 1024 * 1024 * 1024, vpusPerGB: 20},
+		},
+		{
+			name:          "invalid size",
+			volumeContext: map[string]string{ephemeralVolumeSizeKey: "large"},
+			wantErr:       true,
+		},
+		{
+			name:          "invalid performance level",
+			volumeContext: map[string]string{"vpusPerGB": "150"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractEphemeralVolumeParameters(tt.volumeContext)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("extractEphemeralVolumeParameters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("extractEphemeralVolumeParameters() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEphemeralVolumeState(t *testing.T) {
+	defer func(dir string) { kubeletPluginsDir = dir }(kubeletPluginsDir)
+	kubeletPluginsDir = t.TempDir()
+
+	if state, err := readEphemeralVolumeState("csi-persistent"); err != nil || state != nil {
+		t.Fatalf("readEphemeralVolumeState() of a persistent volume = %v, %v, want nil", state, err)
+	}
+	want := &ephemeralVolumeState{VolumeID: "ocid1.volume.oc1.ephemeral", InstanceID: "sample-provider-id", CompartmentID: "compartment"}
+	if err := writeEphemeralVolumeState("csi-ephemeral", want); err != nil {
+		t.Fatalf("writeEphemeralVolumeState() failed: %v", err)
+	}
+	got, err := readEphemeralVolumeState("csi-ephemeral")
+	if err != nil {
+		t.Fatalf("readEphemeralVolumeState() failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readEphemeralVolumeState() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCleanupEphemeralVolumes(t *testing.T) {
+	ephemeralVolume := func(id, compartmentID, instanceID string) core.Volume {
+		return core.Volume{
+			Id:             common.String(id),
+			CompartmentId:  common.String(compartmentID),
+			LifecycleState: core.VolumeLifecycleStateAvailable,
+			FreeformTags:   map[string]string{ephemeralVolumeTag: "csi-" + id, ephemeralInstanceTag: instanceID},
+		}
+	}
+	defer func(volumes []core.Volume) { listed_volumes = volumes }(listed_volumes)
+	listed_volumes = []core.Volume{
+		ephemeralVolume("ephemeral-terminated-instance", "compartment", "ocid1.instance.oc1.terminated"),
+		ephemeralVolume("ephemeral-running-instance", "compartment", "sample-provider-id"),
+		// still attached to the terminated instance
+		ephemeralVolume("ocid1.volume.oc1.attached", "compartment", "ocid1.instance.oc1.terminated"),
+		// in the compartment of a node, not of the cluster
+		ephemeralVolume("ephemeral-node-compartment", "node-compartment", "ocid1.instance.oc1.terminated"),
+		// in a compartment without nodes
+		ephemeralVolume("ephemeral-other-compartment", "other-compartment", "ocid1.instance.oc1.terminated"),
+		{
+			Id:             common.String("persistent"),
+			CompartmentId:  common.String("compartment"),
+			LifecycleState: core.VolumeLifecycleStateAvailable,
+		},
+	}
+	instances["ocid1.instance.oc1.terminated"] = &core.Instance{
+		Id:             common.String("ocid1.instance.oc1.terminated"),
+		LifecycleState: core.InstanceLifecycleStateTerminated,
+	}
+	defer delete(instances, "ocid1.instance.oc1.terminated")
+	deleted_volumes = map[string]bool{}
+
+	d := &BlockVolumeControllerDriver{ControllerDriver{
+		KubeClient: fake.NewSimpleClientset(&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node1",
+				Annotations: map[string]string{util.CompartmentIDAnnotation: "node-compartment"},
+			},
+		}),
+		logger: zap.S(),
+		config: &providercfg.Config{CompartmentID: "compartment"},
+		client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+	}}
+	d.cleanupEphemeralVolumes(context.Background())
+
+	want := map[string]bool{"ephemeral-terminated-instance": true, "ephemeral-node-compartment": true}
+	if !reflect.DeepEqual(deleted_volumes, want) {
+		t.Errorf("deleted volumes = %v, want %v", deleted_volumes, want)
+	}
+}
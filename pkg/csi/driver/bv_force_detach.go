@@ -0,0 +1,346 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+const (
+	// forceDetachTimeout is the timeout of detaching the volume of a stuck
+	// volume attachment
+	forceDetachTimeout = 3 * time.Minute
+	// forceDetachedReason is the reason of the events of the volumes force
+	// detached
+	forceDetachedReason = "ForceDetached"
+	// forceDetachFailedReason is the reason of the events of the volumes which
+	// failed to be force detached
+	forceDetachFailedReason = "ForceDetachFailed"
+	// forceDetachSkippedReason is the reason of the events of the stuck
+	// volume attachments whose volumes have no OCI attachment found
+	forceDetachSkippedReason = "ForceDetachSkipped"
+)
+
+// driverNameInvalidChars are the characters of driver names replaced in the
+// finalizers of the external-attacher
+var driverNameInvalidChars = regexp.MustCompile("[^a-zA-Z0-9-]")
+
+// attacherFinalizer returns the finalizer the external-attacher adds to the
+// volume attachments of the driver, the driver name with the characters
+// other than alphanumerics and dashes replaced with dashes.
+func attacherFinalizer(driverName string) string {
+	name := driverNameInvalidChars.ReplaceAllString(driverName, "-")
+	if name[len(name)-1] == '-' {
+		name = name + "X"
+	}
+	return "external-attacher/" + name
+}
+
+// stuckVolumeAttachment is a volume attachment being deleted whose node or
+// instance is gone.
+type stuckVolumeAttachment struct {
+	va *storagev1.VolumeAttachment
+	// volumeID is the OCID of the volume
+	volumeID string
+	// instanceID is the OCID of the terminated instance of the node, empty if
+	// the node is deleted
+	instanceID string
+	// compartmentID is the compartment the volume attachments are listed in
+	compartmentID string
+}
+
+// runForceDetachReconciler force detaches the volumes of the volume
+// attachments stuck on deleted nodes or terminated instances every period,
+// once they are deleted for the grace period.
+func (d *BlockVolumeControllerDriver) runForceDetachReconciler(period, gracePeriod time.Duration, stopCh <-chan struct{}) {
+	if d.KubeClient == nil {
+		return
+	}
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: d.KubeClient.CoreV1().Events("")})
+	defer eventBroadcaster.Shutdown()
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: BlockVolumeDriverName})
+
+	wait.Until(func() {
+		d.reconcileForceDetach(context.Background(), recorder, gracePeriod)
+	}, period, stopCh)
+}
+
+// reconcileForceDetach force detaches the volumes of the volume attachments
+// stuck on deleted nodes or terminated instances, and removes the
+// external-attacher finalizer of the volume attachments once the volumes are
+// detached.
+func (d *BlockVolumeControllerDriver) reconcileForceDetach(ctx context.Context, recorder record.EventRecorder, gracePeriod time.Duration) {
+	volumeAttachments, err := d.KubeClient.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.logger.With(zap.Error(err)).Error("Failed to list volume attachments, skipping the force detach.")
+		return
+	}
+	nodeList, err := d.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		d.logger.With(zap.Error(err)).Error("Failed to list nodes, skipping the force detach.")
+		return
+	}
+	nodes := make(map[string]*v1.Node, len(nodeList.Items))
+	nodeInstanceIDs := make(map[string]bool, len(nodeList.Items))
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		nodes[node.Name] = node
+		if node.Spec.ProviderID != "" {
+			nodeInstanceIDs[client.MapProviderIDToInstanceID(node.Spec.ProviderID)] = true
+		}
+	}
+
+	finalizer := attacherFinalizer(BlockVolumeDriverName)
+	for i := range volumeAttachments.Items {
+		va := &volumeAttachments.Items[i]
+		if va.Spec.Attacher != BlockVolumeDriverName || va.DeletionTimestamp == nil ||
+			time.Since(va.DeletionTimestamp.Time) < gracePeriod || !hasFinalizer(va.Finalizers, finalizer) {
+			continue
+		}
+		stuck, ok := d.stuckVolumeAttachment(ctx, va, nodes[va.Spec.NodeName])
+		if !ok {
+			continue
+		}
+		d.forceDetach(ctx, recorder, stuck, nodeInstanceIDs, finalizer)
+	}
+}
+
+// stuckVolumeAttachment returns the volume attachment if its node is deleted
+// or its instance is terminated.
+func (d *BlockVolumeControllerDriver) stuckVolumeAttachment(ctx context.Context, va *storagev1.VolumeAttachment, node *v1.Node) (stuckVolumeAttachment, bool) {
+	log := d.logger.With("volumeAttachment", va.Name, "nodeName", va.Spec.NodeName)
+	stuck := stuckVolumeAttachment{va: va, compartmentID: d.config.CompartmentID}
+
+	if node != nil {
+		if node.Spec.ProviderID == "" {
+			return stuck, false
+		}
+		stuck.instanceID = client.MapProviderIDToInstanceID(node.Spec.ProviderID)
+		instance, err := d.client.Compute().GetInstance(ctx, stuck.instanceID)
+		if err != nil && !client.IsNotFound(err) {
+			log.With(zap.Error(err)).With("instanceID", stuck.instanceID).Error("Failed to get the instance of the node, skipping the force detach.")
+			return stuck, false
+		}
+		if err == nil && !client.IsInstanceInTerminalState(instance) {
+			return stuck, false
+		}
+		if compartmentID := node.Annotations[util.CompartmentIDAnnotation]; compartmentID != "" {
+			stuck.compartmentID = compartmentID
+		}
+	}
+
+	if va.Spec.Source.PersistentVolumeName != nil {
+		pv, err := d.KubeClient.CoreV1().PersistentVolumes().Get(ctx, *va.Spec.Source.PersistentVolumeName, metav1.GetOptions{})
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to get the persistent volume of the volume attachment, skipping the force detach.")
+			return stuck, false
+		}
+		if pv.Spec.CSI != nil {
+			stuck.volumeID = pv.Spec.CSI.VolumeHandle
+		}
+	} else if spec := va.Spec.Source.InlineVolumeSpec; spec != nil && spec.CSI != nil {
+		stuck.volumeID = spec.CSI.VolumeHandle
+	}
+	if stuck.volumeID == "" {
+		log.Warn("Volume attachment has no CSI volume, skipping the force detach.")
+		return stuck, false
+	}
+	return stuck, true
+}
+
+// forceDetach detaches the volume of the stuck volume attachment from its
+// instance, and removes the external-attacher finalizer of the volume
+// attachment only once the detach is confirmed. If no attachment of the
+// volume is found, neither in the compartment of the node nor in the
+// compartment of the volume, the volume attachment is left as is, since the
+// volume may still be attached.
+func (d *BlockVolumeControllerDriver) forceDetach(ctx context.Context, recorder record.EventRecorder, stuck stuckVolumeAttachment, nodeInstanceIDs map[string]bool, finalizer string) {
+	startTime := time.Now()
+	log := d.logger.With("volumeAttachment", stuck.va.Name, "nodeName", stuck.va.Spec.NodeName, "volumeID", stuck.volumeID,
+		"instanceID", stuck.instanceID, "csiOperation", "forceDetach")
+	dimensionsMap := map[string]string{
+		metrics.ResourceOCIDDimension: stuck.volumeID,
+		metrics.InstanceIdDimension:   stuck.instanceID,
+	}
+	object := volumeAttachmentEventObject(stuck.va)
+
+	attachments, err := d.stuckAttachments(ctx, stuck, nodeInstanceIDs, stuck.compartmentID)
+	if err != nil {
+		d.forceDetachFailed(log, recorder, object, dimensionsMap, startTime, err, "Failed to list the attachments of the volume.")
+		return
+	}
+	volumeDeleted := false
+	if len(attachments) == 0 {
+		volume, err := d.client.BlockStorage().GetVolume(ctx, stuck.volumeID)
+		if err != nil && !client.IsNotFound(err) {
+			d.forceDetachFailed(log, recorder, object, dimensionsMap, startTime, err, "Failed to get the volume.")
+			return
+		}
+		if client.IsNotFound(err) || volume.LifecycleState == core.VolumeLifecycleStateTerminated {
+			// a deleted volume is not attached to any instance
+			volumeDeleted = true
+		} else if volume.CompartmentId != nil && *volume.CompartmentId != stuck.compartmentID {
+			attachments, err = d.stuckAttachments(ctx, stuck, nodeInstanceIDs, *volume.CompartmentId)
+			if err != nil {
+				d.forceDetachFailed(log, recorder, object, dimensionsMap, startTime, err, "Failed to list the attachments of the volume in its compartment.")
+				return
+			}
+		}
+	}
+	if len(attachments) == 0 && !volumeDeleted {
+		log.Warn("No attachment of the volume found, skipping the force detach.")
+		recorder.Eventf(object, v1.EventTypeWarning, forceDetachSkippedReason,
+			"No attachment of volume %s to the deleted node or terminated instance of node %s found, the volume attachment is not force detached", stuck.volumeID, stuck.va.Spec.NodeName)
+		return
+	}
+
+	for _, attachment := range attachments {
+		attachmentLog := log.With("volumeAttachedId", *attachment.GetId(), "attachmentInstanceID", *attachment.GetInstanceId())
+		if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateDetaching {
+			attachmentLog.Info("Force detaching volume.")
+			if err := d.client.Compute().DetachVolume(ctx, *attachment.GetId()); err != nil {
+				d.forceDetachFailed(attachmentLog, recorder, object, dimensionsMap, startTime, err, "Failed to force detach the volume.")
+				return
+			}
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, forceDetachTimeout)
+		err := d.client.Compute().WaitForVolumeDetached(waitCtx, *attachment.GetId())
+		cancel()
+		if err != nil {
+			d.forceDetachFailed(attachmentLog, recorder, object, dimensionsMap, startTime, err, "Timed out waiting for the volume to be force detached.")
+			return
+		}
+		attachmentLog.Info("Volume is force detached.")
+	}
+
+	if err := d.removeVolumeAttachmentFinalizer(ctx, stuck.va, finalizer); err != nil {
+		d.forceDetachFailed(log, recorder, object, dimensionsMap, startTime, err, "Failed to remove the finalizer of the volume attachment.")
+		return
+	}
+	log.Info("Removed the finalizer of the force detached volume attachment.")
+	recorder.Eventf(object, v1.EventTypeNormal, forceDetachedReason,
+		"Force detached volume %s from the deleted node or terminated instance of node %s", stuck.volumeID, stuck.va.Spec.NodeName)
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.Success, util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.PVForceDetach, time.Since(startTime).Seconds(), dimensionsMap)
+}
+
+// stuckAttachments returns the attachments of the volume of the stuck volume
+// attachment in the compartment which are to be force detached.
+//
+// If the node of the volume attachment is deleted, its instance is unknown:
+// the attachments to the instances which are not nodes of the cluster and are
+// terminated or not found are returned.
+func (d *BlockVolumeControllerDriver) stuckAttachments(ctx context.Context, stuck stuckVolumeAttachment, nodeInstanceIDs map[string]bool, compartmentID string) ([]core.VolumeAttachment, error) {
+	attachments, err := d.client.Compute().ListVolumeAttachments(ctx, compartmentID, stuck.volumeID)
+	if err != nil && !client.IsNotFound(err) {
+		return nil, err
+	}
+
+	var stuckAttachments []core.VolumeAttachment
+	for _, attachment := range attachments {
+		if attachment.GetInstanceId() == nil || attachment.GetId() == nil {
+			continue
+		}
+		instanceID := *attachment.GetInstanceId()
+		if stuck.instanceID != "" && instanceID != stuck.instanceID {
+			continue
+		}
+		if stuck.instanceID == "" {
+			if nodeInstanceIDs[instanceID] {
+				continue
+			}
+			// the instance of a node deleted from the cluster may still be
+			// running with the volume mounted
+			instance, err := d.client.Compute().GetInstance(ctx, instanceID)
+			if err != nil && !client.IsNotFound(err) {
+				return nil, errors.Wrapf(err, "failed to get the instance %s of the attachment", instanceID)
+			}
+			if err == nil && !client.IsInstanceInTerminalState(instance) {
+				continue
+			}
+		}
+		stuckAttachments = append(stuckAttachments, attachment)
+	}
+	return stuckAttachments, nil
+}
+
+func (d *BlockVolumeControllerDriver) forceDetachFailed(log *zap.SugaredLogger, recorder record.EventRecorder, object *v1.ObjectReference,
+	dimensionsMap map[string]string, startTime time.Time, err error, msg string) {
+	log.With(zap.Error(err)).Error(msg)
+	recorder.Eventf(object, v1.EventTypeWarning, forceDetachFailedReason, "%s: %v", msg, err)
+	dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(util.GetError(err), util.CSIStorageType)
+	metrics.SendMetricData(d.metricPusher, metrics.PVForceDetach, time.Since(startTime).Seconds(), dimensionsMap)
+}
+
+// removeVolumeAttachmentFinalizer removes the finalizer of the volume
+// attachment, failing if the finalizers changed since the volume attachment
+// was listed.
+func (d *BlockVolumeControllerDriver) removeVolumeAttachmentFinalizer(ctx context.Context, va *storagev1.VolumeAttachment, finalizer string) error {
+	var finalizers []string
+	for _, f := range va.Finalizers {
+		if f != finalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": "/metadata/finalizers", "value": va.Finalizers},
+		{"op": "replace", "path": "/metadata/finalizers", "value": finalizers},
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = d.KubeClient.StorageV1().VolumeAttachments().Patch(ctx, va.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// volumeAttachmentEventObject returns the object the force detach events of
+// the volume attachment are recorded on.
+func volumeAttachmentEventObject(va *storagev1.VolumeAttachment) *v1.ObjectReference {
+	return &v1.ObjectReference{
+		APIVersion: storagev1.SchemeGroupVersion.String(),
+		Kind:       "VolumeAttachment",
+		Name:       va.Name,
+		UID:        va.UID,
+	}
+}
+
+func hasFinalizer(finalizers []string, finalizer string) bool {
+	for _, f := range finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
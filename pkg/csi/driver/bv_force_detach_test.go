@@ -0,0 +1,157 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+)
+
+func TestAttacherFinalizer(t *testing.T) {
+	tests := map[string]string{
+		BlockVolumeDriverName: "external-attacher/blockvolume-csi-oraclecloud-com",
+		"driver-":             "external-attacher/driver-X",
+	}
+	for driverName, want := range tests {
+		if got := attacherFinalizer(driverName); got != want {
+			t.Errorf("attacherFinalizer(%q) = %q, want %q", driverName, got, want)
+		}
+	}
+}
+
+func TestReconcileForceDetach(t *testing.T) {
+	finalizer := attacherFinalizer(BlockVolumeDriverName)
+	pvName := "pv-force-detach"
+	volumeAttachment := func(name, nodeName string, deleted time.Duration) *storagev1.VolumeAttachment {
+		return &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Finalizers:        []string{finalizer},
+				DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-deleted)},
+			},
+			Spec: storagev1.VolumeAttachmentSpec{
+				Attacher: BlockVolumeDriverName,
+				NodeName: nodeName,
+				Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: &pvName},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		va             *storagev1.VolumeAttachment
+		volumeID       string
+		nodes          []v1.Node
+		instanceState  core.InstanceLifecycleStateEnum
+		wantFinalizers []string
+	}{
+		{
+			name:           "deleted node of a terminated instance",
+			va:             volumeAttachment("va-deleted-node", "deleted-node", time.Hour),
+			volumeID:       "ocid1.volume.oc1.attached",
+			instanceState:  core.InstanceLifecycleStateTerminated,
+			wantFinalizers: nil,
+		},
+		{
+			name:           "deleted node of a running instance",
+			va:             volumeAttachment("va-deleted-node-running-instance", "deleted-node", time.Hour),
+			volumeID:       "ocid1.volume.oc1.attached",
+			instanceState:  core.InstanceLifecycleStateRunning,
+			wantFinalizers: []string{finalizer},
+		},
+		{
+			name:           "deleted node without attachment of the volume",
+			va:             volumeAttachment("va-no-attachment", "deleted-node", time.Hour),
+			volumeID:       "ocid1.volume.oc1.modify",
+			instanceState:  core.InstanceLifecycleStateTerminated,
+			wantFinalizers: []string{finalizer},
+		},
+		{
+			name:           "deleted node within the grace period",
+			va:             volumeAttachment("va-grace-period", "deleted-node", time.Minute),
+			volumeID:       "ocid1.volume.oc1.attached",
+			instanceState:  core.InstanceLifecycleStateTerminated,
+			wantFinalizers: []string{finalizer},
+		},
+		{
+			name:     "running instance",
+			va:       volumeAttachment("va-running-instance", "running-node", time.Hour),
+			volumeID: "ocid1.volume.oc1.attached",
+			nodes: []v1.Node{{
+				ObjectMeta: metav1.ObjectMeta{Name: "running-node"},
+				Spec:       v1.NodeSpec{ProviderID: "sample-provider-id"},
+			}},
+			wantFinalizers: []string{finalizer},
+		},
+	}
+	// the attachment of the attached volume is detached once detaching
+	volume_attachments["ocid1.volumeattachment.oc1.attached"] = &core.IScsiVolumeAttachment{
+		LifecycleState: core.VolumeAttachmentLifecycleStateDetached,
+		Id:             common.String("ocid1.volumeattachment.oc1.attached"),
+	}
+	defer delete(volume_attachments, "ocid1.volumeattachment.oc1.attached")
+
+	defer delete(instances, "ocid1.instance.oc1.node1")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the instance of the attachment of the attached volume
+			instances["ocid1.instance.oc1.node1"] = &core.Instance{
+				Id:             common.String("ocid1.instance.oc1.node1"),
+				LifecycleState: tt.instanceState,
+			}
+			kubeClient := fake.NewSimpleClientset(tt.va, &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: pvName},
+				Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{
+					CSI: &v1.CSIPersistentVolumeSource{Driver: BlockVolumeDriverName, VolumeHandle: tt.volumeID},
+				}},
+			})
+			for i := range tt.nodes {
+				if _, err := kubeClient.CoreV1().Nodes().Create(context.Background(), &tt.nodes[i], metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				KubeClient: kubeClient,
+				logger:     zap.S(),
+				config:     &providercfg.Config{CompartmentID: ""},
+				client:     NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+			}}
+
+			d.reconcileForceDetach(context.Background(), record.NewFakeRecorder(10), 10*time.Minute)
+
+			va, err := kubeClient.StorageV1().VolumeAttachments().Get(context.Background(), tt.va.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(va.Finalizers, tt.wantFinalizers) {
+				t.Errorf("finalizers = %v, want %v", va.Finalizers, tt.wantFinalizers)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"strings"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+)
+
+// validateFilesystemOptions validates the mkfs options and the default mount
+// options of the storage class against the filesystem types of the volume
+// capabilities, and normalizes the default mount options.
+func validateFilesystemOptions(log *zap.SugaredLogger, volumeCapabilities []*csi.VolumeCapability, params *VolumeParameters) error {
+	for _, capability := range volumeCapabilities {
+		mnt := capability.GetMount()
+		if mnt == nil {
+			continue
+		}
+		fsType := csi_util.ValidateFsType(log, mnt.FsType)
+		if params.mkfsOptions != "" {
+			if _, err := csi_util.ValidateMkfsOptions(fsType, params.mkfsOptions); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid %s provided for storageclass: %v", mkfsOptionsKey, err)
+			}
+		}
+		if params.defaultMountOptions != "" {
+			options, err := csi_util.ValidateMountOptions(fsType, params.defaultMountOptions)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid %s provided for storageclass: %v", defaultMountOptionsKey, err)
+			}
+			params.defaultMountOptions = strings.Join(options, ",")
+		}
+	}
+	return nil
+}
+
+// filesystemOptionsFromVolumeContext returns the mkfs options and the default
+// mount options of the volume from its volume context. They are validated
+// again, as the volume attributes of statically provisioned volumes are not
+// validated by the controller.
+func filesystemOptionsFromVolumeContext(fsType string, volumeContext map[string]string) (mkfsOptions []string, defaultMountOptions []string, err error) {
+	if v := volumeContext[mkfsOptionsKey]; v != "" {
+		if mkfsOptions, err = csi_util.ValidateMkfsOptions(fsType, v); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid %s in volume context: %v", mkfsOptionsKey, err)
+		}
+	}
+	if v := volumeContext[defaultMountOptionsKey]; v != "" {
+		if defaultMountOptions, err = csi_util.ValidateMountOptions(fsType, v); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid %s in volume context: %v", defaultMountOptionsKey, err)
+		}
+	}
+	return mkfsOptions, defaultMountOptions, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateFilesystemOptions(t *testing.T) {
+	mountCapability := func(fsType string) *csi.VolumeCapability {
+		return &csi.VolumeCapability{AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{FsType: fsType}}}
+	}
+	blockCapability := &csi.VolumeCapability{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}}
+
+	tests := []struct {
+		name                    string
+		capabilities            []*csi.VolumeCapability
+		params                  VolumeParameters
+		wantDefaultMountOptions string
+		wantCode                codes.Code
+	}{
+		{
+			name:         "no filesystem options",
+			capabilities: []*csi.VolumeCapability{mountCapability("xfs")},
+		},
+		{
+			name:                    "xfs options",
+			capabilities:            []*csi.VolumeCapability{mountCapability("xfs")},
+			params:                  VolumeParameters{mkfsOptions: "-m reflink=1", defaultMountOptions: "noatime, largeio"},
+			wantDefaultMountOptions: "noatime,largeio",
+		},
+		{
+			name:         "xfs mkfs options for the default ext4 fsType",
+			capabilities: []*csi.VolumeCapability{mountCapability("")},
+			params:       VolumeParameters{mkfsOptions: "-m reflink=1"},
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:         "mount option not allowed",
+			capabilities: []*csi.VolumeCapability{mountCapability("ext4")},
+			params:       VolumeParameters{defaultMountOptions: "exec"},
+			wantCode:     codes.InvalidArgument,
+		},
+		{
+			name:                    "raw block volume",
+			capabilities:            []*csi.VolumeCapability{blockCapability},
+			params:                  VolumeParameters{mkfsOptions: "-m reflink=1", defaultMountOptions: "largeio"},
+			wantDefaultMountOptions: "largeio",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFilesystemOptions(zap.S(), tt.capabilities, &tt.params)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("validateFilesystemOptions() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && tt.params.defaultMountOptions != tt.wantDefaultMountOptions {
+				t.Errorf("validateFilesystemOptions() default mount options = %q, want %q", tt.params.defaultMountOptions, tt.wantDefaultMountOptions)
+			}
+		})
+	}
+}
+
+func TestFilesystemOptionsFromVolumeContext(t *testing.T) {
+	tests := []struct {
+		name                    string
+		fsType                  string
+		volumeContext           map[string]string
+		wantMkfsOptions         []string
+		wantDefaultMountOptions []string
+		wantCode                codes.Code
+	}{
+		{
+			name:   "volume without filesystem options",
+			fsType: "ext4",
+		},
+		{
+			name:                    "volume with filesystem options",
+			fsType:                  "ext4",
+			volumeContext:           map[string]string{mkfsOptionsKey: "-E lazy_itable_init=0 -i 16384", defaultMountOptionsKey: "noatime,discard"},
+			wantMkfsOptions:         []string{"-E", "lazy_itable_init=0", "-i", "16384"},
+			wantDefaultMountOptions: []string{"noatime", "discard"},
+		},
+		{
+			name:          "mkfs option not allowed",
+			fsType:        "xfs",
+			volumeContext: map[string]string{mkfsOptionsKey: "-p /etc/shadow"},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "mount option not allowed",
+			fsType:        "xfs",
+			volumeContext: map[string]string{defaultMountOptionsKey: "noatime,dev"},
+			wantCode:      codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mkfsOptions, defaultMountOptions, err := filesystemOptionsFromVolumeContext(tt.fsType, tt.volumeContext)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("filesystemOptionsFromVolumeContext() error = %v, want code %v", err, tt.wantCode)
+			}
+			if !reflect.DeepEqual(mkfsOptions, tt.wantMkfsOptions) {
+				t.Errorf("filesystemOptionsFromVolumeContext() mkfs options = %v, want %v", mkfsOptions, tt.wantMkfsOptions)
+			}
+			if !reflect.DeepEqual(defaultMountOptions, tt.wantDefaultMountOptions) {
+				t.Errorf("filesystemOptionsFromVolumeContext() default mount options = %v, want %v", defaultMountOptions, tt.wantDefaultMountOptions)
+			}
+		})
+	}
+}
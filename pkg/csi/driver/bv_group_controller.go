@@ -0,0 +1,381 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+// GroupControllerGetCapabilities returns the supported capabilities of the group controller service.
+func (d *BlockVolumeControllerDriver) GroupControllerGetCapabilities(ctx context.Context, req *csi.GroupControllerGetCapabilitiesRequest) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	return &csi.GroupControllerGetCapabilitiesResponse{
+		Capabilities: []*csi.GroupControllerServiceCapability{
+			{
+				Type: &csi.GroupControllerServiceCapability_Rpc{
+					Rpc: &csi.GroupControllerServiceCapability_RPC{
+						Type: csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// CreateVolumeGroupSnapshot takes a crash consistent snapshot of the source volumes. A transient volume group of
+// the volumes is created and backed up, each volume backup of the volume group backup is a snapshot of the group
+// snapshot. The volume group is deleted once the point in time of the backup is committed. The function is
+// idempotent, the snapshot is not ready to use until the volume group backup is available.
+func (d *BlockVolumeControllerDriver) CreateVolumeGroupSnapshot(ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.Name
+	sendMetric := func(component string) {
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(component, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotProvision, time.Since(startTime).Seconds(), dimensionsMap)
+	}
+	log := d.logger.With("groupSnapshotName", req.Name, "sourceVolumeIds", req.SourceVolumeIds, "csiOperation", "createGroupSnapshot")
+
+	if req.Name == "" {
+		sendMetric(util.ErrValidation)
+		return nil, status.Error(codes.InvalidArgument, "Volume group snapshot name must be provided")
+	}
+	if len(req.SourceVolumeIds) == 0 {
+		sendMetric(util.ErrValidation)
+		return nil, status.Error(codes.InvalidArgument, "Volume group snapshot source volume IDs must be provided")
+	}
+	for _, volumeID := range req.SourceVolumeIds {
+		if client.IsBootVolume(volumeID) {
+			sendMetric(util.ErrValidation)
+			return nil, status.Error(codes.InvalidArgument, "Volume group snapshot feature not available for boot volumes")
+		}
+	}
+
+	groupBackups, err := d.client.BlockStorage().GetVolumeGroupBackupsByName(ctx, req.Name, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the volume group backup.")
+		sendMetric(util.GetError(err))
+		return nil, status.Errorf(codes.Internal, "failed to check existence of group snapshot %v", err)
+	}
+	if len(groupBackups) > 1 {
+		sendMetric(util.ErrValidation)
+		return nil, status.Errorf(codes.Internal, "duplicate group snapshot %q exists", req.Name)
+	}
+
+	var groupBackup *core.VolumeGroupBackup
+	if len(groupBackups) > 0 {
+		groupBackup = &groupBackups[0]
+		log.With("volumeGroupBackupId", *groupBackup.Id).Info("Volume group backup already created.")
+	} else {
+		snapshotParams, err := extractSnapshotParameters(req.GetParameters())
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to parse volumegroupsnapshotclass parameters.")
+			sendMetric(util.ErrValidation)
+			return nil, err
+		}
+
+		volumeGroup, err := d.transientVolumeGroup(ctx, log, req.Name, req.SourceVolumeIds)
+		if err != nil {
+			sendMetric(util.GetError(err))
+			return nil, err
+		}
+
+		backupType := core.CreateVolumeGroupBackupDetailsTypeIncremental
+		if snapshotParams.backupType == core.CreateVolumeBackupDetailsTypeFull {
+			backupType = core.CreateVolumeGroupBackupDetailsTypeFull
+		}
+		groupBackup, err = d.client.BlockStorage().CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupDetails{
+			VolumeGroupId: volumeGroup.Id,
+			CompartmentId: &d.config.CompartmentID,
+			DisplayName:   &req.Name,
+			Type:          backupType,
+			FreeformTags:  snapshotParams.freeformTags,
+			DefinedTags:   snapshotParams.definedTags,
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to create the volume group backup.")
+			sendMetric(util.GetError(err))
+			return nil, status.Errorf(codes.Internal, "could not create group snapshot %q: %v", req.Name, err)
+		}
+		log.With("volumeGroupBackupId", *groupBackup.Id).Info("Volume group backup is created.")
+	}
+	dimensionsMap[metrics.ResourceOCIDDimension] = *groupBackup.Id
+
+	if groupBackup.LifecycleState != core.VolumeGroupBackupLifecycleStateCommitted &&
+		groupBackup.LifecycleState != core.VolumeGroupBackupLifecycleStateAvailable {
+		log.Info("Volume group backup is not committed yet, controller will retry.")
+		sendMetric(util.BackupCreating)
+		return nil, status.Errorf(codes.DeadlineExceeded, "waiting for group snapshot %q to be committed", req.Name)
+	}
+
+	// the point in time of the backup is taken, the volumes can leave the volume group
+	if groupBackup.VolumeGroupId != nil {
+		if err := d.deleteTransientVolumeGroup(ctx, *groupBackup.VolumeGroupId); err != nil {
+			log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to delete the transient volume group.")
+			sendMetric(util.GetError(err))
+			return nil, status.Errorf(codes.Internal, "failed to delete the volume group of group snapshot %q: %v", req.Name, err)
+		}
+	}
+
+	groupSnapshot, err := d.volumeGroupSnapshot(ctx, groupBackup)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the volume backups of the volume group backup.")
+		sendMetric(util.GetError(err))
+		return nil, err
+	}
+	if !sameVolumes(groupSnapshot, req.SourceVolumeIds) {
+		sendMetric(util.ErrValidation)
+		return nil, status.Errorf(codes.AlreadyExists, "group snapshot %s exists for other volumes", req.Name)
+	}
+
+	if groupSnapshot.ReadyToUse {
+		log.Info("Volume group snapshot is created and available.")
+		sendMetric(util.Success)
+	}
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
+
+// transientVolumeGroup returns the volume group of the volumes created for the group snapshot, creating it if
+// needed.
+func (d *BlockVolumeControllerDriver) transientVolumeGroup(ctx context.Context, log *zap.SugaredLogger, name string, volumeIDs []string) (*core.VolumeGroup, error) {
+	volumeGroups, err := d.client.BlockStorage().GetVolumeGroupsByName(ctx, name, d.config.CompartmentID)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to check the existence of the volume group.")
+		return nil, status.Errorf(codes.Internal, "failed to check existence of volume group %v", err)
+	}
+
+	var volumeGroup *core.VolumeGroup
+	if len(volumeGroups) > 0 {
+		volumeGroup = &volumeGroups[0]
+	} else {
+		volume, err := d.client.BlockStorage().GetVolume(ctx, volumeIDs[0])
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to get the source volume.")
+			if client.IsNotFound(err) {
+				return nil, status.Errorf(codes.NotFound, "source volume %s not found", volumeIDs[0])
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get source volume %v", err)
+		}
+
+		volumeGroup, err = d.client.BlockStorage().CreateVolumeGroup(ctx, core.CreateVolumeGroupDetails{
+			AvailabilityDomain: volume.AvailabilityDomain,
+			CompartmentId:      &d.config.CompartmentID,
+			DisplayName:        &name,
+			SourceDetails:      core.VolumeGroupSourceFromVolumesDetails{VolumeIds: volumeIDs},
+		})
+		if err != nil {
+			log.With("service", "blockstorage", "verb", "create", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to create the volume group.")
+			if util.GetHttpStatusCode(err) == http.StatusBadRequest {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to create a volume group of the source volumes, "+
+					"they must be in the same availability domain and in no other volume group: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to create volume group %v", err)
+		}
+		log.With("volumeGroupId", *volumeGroup.Id).Info("Transient volume group is created.")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, newBackupAvailableTimeout)
+	defer cancel()
+	volumeGroup, err = d.client.BlockStorage().AwaitVolumeGroupAvailableOrTimeout(timeoutCtx, *volumeGroup.Id)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Volume group did not become available.")
+		return nil, status.Errorf(codes.DeadlineExceeded, "volume group did not become available %v", err)
+	}
+	return volumeGroup, nil
+}
+
+// deleteTransientVolumeGroup deletes the volume group of a group snapshot if it still exists. The volumes of the
+// volume group are not deleted.
+func (d *BlockVolumeControllerDriver) deleteTransientVolumeGroup(ctx context.Context, volumeGroupID string) error {
+	volumeGroup, err := d.client.BlockStorage().GetVolumeGroup(ctx, volumeGroupID)
+	if client.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminating ||
+		volumeGroup.LifecycleState == core.VolumeGroupLifecycleStateTerminated {
+		return nil
+	}
+	err = d.client.BlockStorage().DeleteVolumeGroup(ctx, volumeGroupID)
+	if client.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// volumeGroupSnapshot returns the group snapshot of the volume group backup.
+func (d *BlockVolumeControllerDriver) volumeGroupSnapshot(ctx context.Context, groupBackup *core.VolumeGroupBackup) (*csi.VolumeGroupSnapshot, error) {
+	groupSnapshot := &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: *groupBackup.Id,
+		ReadyToUse:      groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateAvailable,
+	}
+	if groupBackup.TimeCreated != nil {
+		groupSnapshot.CreationTime = timestamppb.New(groupBackup.TimeCreated.Time)
+	}
+	for _, backupID := range groupBackup.VolumeBackupIds {
+		backup, err := d.client.BlockStorage().GetVolumeBackup(ctx, backupID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get volume backup %s: %v", backupID, err)
+		}
+		entry := snapshotEntry(*backup)
+		if entry == nil {
+			return nil, status.Errorf(codes.Internal, "volume backup %s of group snapshot %s is terminated", backupID, *groupBackup.Id)
+		}
+		entry.Snapshot.GroupSnapshotId = *groupBackup.Id
+		// the snapshots are usable once the whole group is
+		entry.Snapshot.ReadyToUse = entry.Snapshot.ReadyToUse && groupSnapshot.ReadyToUse
+		groupSnapshot.Snapshots = append(groupSnapshot.Snapshots, entry.Snapshot)
+	}
+	return groupSnapshot, nil
+}
+
+// sameVolumes returns true if the snapshots of the group snapshot are the snapshots of the volumes.
+func sameVolumes(groupSnapshot *csi.VolumeGroupSnapshot, volumeIDs []string) bool {
+	var snapshotVolumeIDs []string
+	for _, snapshot := range groupSnapshot.Snapshots {
+		snapshotVolumeIDs = append(snapshotVolumeIDs, snapshot.SourceVolumeId)
+	}
+	wantVolumeIDs := append([]string(nil), volumeIDs...)
+	sort.Strings(snapshotVolumeIDs)
+	sort.Strings(wantVolumeIDs)
+	if len(snapshotVolumeIDs) != len(wantVolumeIDs) {
+		return false
+	}
+	for i := range wantVolumeIDs {
+		if snapshotVolumeIDs[i] != wantVolumeIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteVolumeGroupSnapshot deletes the volume group backup of a group snapshot, with its volume backups.
+func (d *BlockVolumeControllerDriver) DeleteVolumeGroupSnapshot(ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	startTime := time.Now()
+	dimensionsMap := make(map[string]string)
+	dimensionsMap[metrics.ResourceOCIDDimension] = req.GroupSnapshotId
+	sendMetric := func(component string) {
+		dimensionsMap[metrics.ComponentDimension] = util.GetMetricDimensionForComponent(component, util.CSIStorageType)
+		metrics.SendMetricData(d.metricPusher, metrics.BlockGroupSnapshotDelete, time.Since(startTime).Seconds(), dimensionsMap)
+	}
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "deleteGroupSnapshot")
+
+	if req.GroupSnapshotId == "" {
+		sendMetric(util.ErrValidation)
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupBackup, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if client.IsNotFound(err) {
+		log.Info("Volume group backup is already deleted.")
+		sendMetric(util.Success)
+		return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+	}
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the volume group backup.")
+		sendMetric(util.GetError(err))
+		return nil, status.Errorf(codes.Internal, "failed to get group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+
+	if groupBackup.VolumeGroupId != nil {
+		if err := d.deleteTransientVolumeGroup(ctx, *groupBackup.VolumeGroupId); err != nil {
+			log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to delete the transient volume group.")
+			sendMetric(util.GetError(err))
+			return nil, status.Errorf(codes.Internal, "failed to delete the volume group of group snapshot %s: %v", req.GroupSnapshotId, err)
+		}
+	}
+
+	if groupBackup.LifecycleState != core.VolumeGroupBackupLifecycleStateTerminating &&
+		groupBackup.LifecycleState != core.VolumeGroupBackupLifecycleStateTerminated {
+		err = d.client.BlockStorage().DeleteVolumeGroupBackup(ctx, req.GroupSnapshotId)
+		if err != nil && !client.IsNotFound(err) {
+			log.With("service", "blockstorage", "verb", "delete", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Error("Failed to delete the volume group backup.")
+			sendMetric(util.GetError(err))
+			return nil, status.Errorf(codes.Internal, "failed to delete group snapshot %s: %v", req.GroupSnapshotId, err)
+		}
+	}
+
+	log.Info("Volume group snapshot is deleted.")
+	sendMetric(util.Success)
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+// GetVolumeGroupSnapshot returns the group snapshot of a volume group backup.
+func (d *BlockVolumeControllerDriver) GetVolumeGroupSnapshot(ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	log := d.logger.With("groupSnapshotId", req.GroupSnapshotId, "csiOperation", "getGroupSnapshot")
+
+	if req.GroupSnapshotId == "" {
+		return nil, status.Error(codes.InvalidArgument, "GroupSnapshotId must be provided")
+	}
+
+	groupBackup, err := d.client.BlockStorage().GetVolumeGroupBackup(ctx, req.GroupSnapshotId)
+	if client.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "group snapshot %s not found", req.GroupSnapshotId)
+	}
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "volumeGroupBackup", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the volume group backup.")
+		return nil, status.Errorf(codes.Internal, "failed to get group snapshot %s: %v", req.GroupSnapshotId, err)
+	}
+	if groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminating ||
+		groupBackup.LifecycleState == core.VolumeGroupBackupLifecycleStateTerminated {
+		return nil, status.Errorf(codes.NotFound, "group snapshot %s is deleted", req.GroupSnapshotId)
+	}
+
+	groupSnapshot, err := d.volumeGroupSnapshot(ctx, groupBackup)
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to get the volume backups of the volume group backup.")
+		return nil, err
+	}
+
+	if len(req.SnapshotIds) > 0 {
+		snapshotIDs := make(map[string]bool)
+		for _, snapshot := range groupSnapshot.Snapshots {
+			snapshotIDs[snapshot.SnapshotId] = true
+		}
+		for _, snapshotID := range req.SnapshotIds {
+			if !snapshotIDs[snapshotID] {
+				return nil, status.Errorf(codes.InvalidArgument, "snapshot %s is not in group snapshot %s", snapshotID, req.GroupSnapshotId)
+			}
+		}
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: groupSnapshot}, nil
+}
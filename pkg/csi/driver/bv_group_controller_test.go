@@ -0,0 +1,247 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/logging"
+)
+
+// setupVolumeGroupBackups resets the volume groups and backups of the mock block storage client to a volume group
+// backup of the volumes ocid1.volume.oc1.a and ocid1.volume.oc1.b, in the given state, and its transient volume
+// group.
+func setupVolumeGroupBackups(state core.VolumeGroupBackupLifecycleStateEnum) {
+	volume_groups = map[string]*core.VolumeGroup{
+		"ocid1.volumegroup.oc1.existing": {
+			Id:             common.String("ocid1.volumegroup.oc1.existing"),
+			DisplayName:    common.String("existing"),
+			LifecycleState: core.VolumeGroupLifecycleStateAvailable,
+			VolumeIds:      []string{"ocid1.volume.oc1.a", "ocid1.volume.oc1.b"},
+		},
+	}
+	volume_group_backups = map[string]*core.VolumeGroupBackup{
+		"ocid1.volumegroupbackup.oc1.existing": {
+			Id:              common.String("ocid1.volumegroupbackup.oc1.existing"),
+			DisplayName:     common.String("existing"),
+			LifecycleState:  state,
+			VolumeGroupId:   common.String("ocid1.volumegroup.oc1.existing"),
+			VolumeBackupIds: []string{"ocid1.volumebackup.oc1.a", "ocid1.volumebackup.oc1.b"},
+			TimeCreated:     &common.SDKTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+	}
+	backupState := core.VolumeBackupLifecycleStateCreating
+	if state == core.VolumeGroupBackupLifecycleStateAvailable {
+		backupState = core.VolumeBackupLifecycleStateAvailable
+	}
+	group_volume_backups = map[string]core.VolumeBackup{
+		"ocid1.volumebackup.oc1.a": {
+			Id:             common.String("ocid1.volumebackup.oc1.a"),
+			VolumeId:       common.String("ocid1.volume.oc1.a"),
+			LifecycleState: backupState,
+			SizeInMBs:      common.Int64(51200),
+		},
+		"ocid1.volumebackup.oc1.b": {
+			Id:             common.String("ocid1.volumebackup.oc1.b"),
+			VolumeId:       common.String("ocid1.volume.oc1.b"),
+			LifecycleState: backupState,
+			SizeInMBs:      common.Int64(51200),
+		},
+	}
+}
+
+func newGroupControllerDriver() *BlockVolumeControllerDriver {
+	return &BlockVolumeControllerDriver{ControllerDriver{
+		logger: zap.S(),
+		config: &providercfg.Config{CompartmentID: "compartment"},
+		client: NewClientProvisioner(nil, &MockBlockStorageClient{}, nil),
+		util:   &csi_util.Util{Logger: logging.Logger().Sugar()},
+	}}
+}
+
+func TestBlockVolumeControllerDriver_CreateVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name                string
+		state               core.VolumeGroupBackupLifecycleStateEnum
+		req                 *csi.CreateVolumeGroupSnapshotRequest
+		wantCode            codes.Code
+		wantReady           bool
+		wantVolumeGroupLeft bool
+	}{
+		{
+			name:     "missing source volumes",
+			req:      &csi.CreateVolumeGroupSnapshotRequest{Name: "new"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "boot volume",
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "new",
+				SourceVolumeIds: []string{"ocid1.bootvolume.oc1.a"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "new group snapshot is not committed yet",
+			state: core.VolumeGroupBackupLifecycleStateAvailable,
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "new",
+				SourceVolumeIds: []string{"volume-in-available-state"},
+			},
+			wantCode:            codes.DeadlineExceeded,
+			wantVolumeGroupLeft: true,
+		},
+		{
+			name:  "committed group snapshot",
+			state: core.VolumeGroupBackupLifecycleStateCommitted,
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "existing",
+				SourceVolumeIds: []string{"ocid1.volume.oc1.b", "ocid1.volume.oc1.a"},
+			},
+		},
+		{
+			name:  "available group snapshot",
+			state: core.VolumeGroupBackupLifecycleStateAvailable,
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "existing",
+				SourceVolumeIds: []string{"ocid1.volume.oc1.a", "ocid1.volume.oc1.b"},
+			},
+			wantReady: true,
+		},
+		{
+			name:  "group snapshot of other volumes",
+			state: core.VolumeGroupBackupLifecycleStateAvailable,
+			req: &csi.CreateVolumeGroupSnapshotRequest{
+				Name:            "existing",
+				SourceVolumeIds: []string{"ocid1.volume.oc1.a"},
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupVolumeGroupBackups(tt.state)
+			got, err := newGroupControllerDriver().CreateVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("CreateVolumeGroupSnapshot() error = %v, want code %v", err, tt.wantCode)
+			}
+			if _, ok := volume_groups["ocid1.volumegroup.oc1."+tt.req.Name]; ok != tt.wantVolumeGroupLeft {
+				t.Errorf("CreateVolumeGroupSnapshot() left the volume group = %t, want %t", ok, tt.wantVolumeGroupLeft)
+			}
+			if err != nil {
+				return
+			}
+			groupSnapshot := got.GroupSnapshot
+			if groupSnapshot.ReadyToUse != tt.wantReady {
+				t.Errorf("CreateVolumeGroupSnapshot() ReadyToUse = %t, want %t", groupSnapshot.ReadyToUse, tt.wantReady)
+			}
+			if len(groupSnapshot.Snapshots) != len(tt.req.SourceVolumeIds) {
+				t.Fatalf("CreateVolumeGroupSnapshot() returned %d snapshots, want %d", len(groupSnapshot.Snapshots), len(tt.req.SourceVolumeIds))
+			}
+			for _, snapshot := range groupSnapshot.Snapshots {
+				if snapshot.GroupSnapshotId != groupSnapshot.GroupSnapshotId || snapshot.ReadyToUse != tt.wantReady {
+					t.Errorf("CreateVolumeGroupSnapshot() returned snapshot %v of group snapshot %s", snapshot, groupSnapshot.GroupSnapshotId)
+				}
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_DeleteVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *csi.DeleteVolumeGroupSnapshotRequest
+		wantCode    codes.Code
+		wantDeleted bool
+	}{
+		{
+			name:     "missing group snapshot id",
+			req:      &csi.DeleteVolumeGroupSnapshotRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "group snapshot already deleted",
+			req:  &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "ocid1.volumegroupbackup.oc1.deleted"},
+		},
+		{
+			name:        "group snapshot",
+			req:         &csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "ocid1.volumegroupbackup.oc1.existing"},
+			wantDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupVolumeGroupBackups(core.VolumeGroupBackupLifecycleStateAvailable)
+			_, err := newGroupControllerDriver().DeleteVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("DeleteVolumeGroupSnapshot() error = %v, want code %v", err, tt.wantCode)
+			}
+			if tt.wantDeleted && (len(volume_group_backups) != 0 || len(volume_groups) != 0) {
+				t.Errorf("DeleteVolumeGroupSnapshot() left %v and %v", volume_group_backups, volume_groups)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_GetVolumeGroupSnapshot(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *csi.GetVolumeGroupSnapshotRequest
+		wantCode      codes.Code
+		wantSnapshots int
+	}{
+		{
+			name:     "group snapshot not found",
+			req:      &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "ocid1.volumegroupbackup.oc1.deleted"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:          "group snapshot",
+			req:           &csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "ocid1.volumegroupbackup.oc1.existing"},
+			wantSnapshots: 2,
+		},
+		{
+			name: "snapshot of another group snapshot",
+			req: &csi.GetVolumeGroupSnapshotRequest{
+				GroupSnapshotId: "ocid1.volumegroupbackup.oc1.existing",
+				SnapshotIds:     []string{"ocid1.volumebackup.oc1.available"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupVolumeGroupBackups(core.VolumeGroupBackupLifecycleStateAvailable)
+			got, err := newGroupControllerDriver().GetVolumeGroupSnapshot(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetVolumeGroupSnapshot() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && len(got.GroupSnapshot.Snapshots) != tt.wantSnapshots {
+				t.Errorf("GetVolumeGroupSnapshot() returned %d snapshots, want %d", len(got.GroupSnapshot.Snapshots), tt.wantSnapshots)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+// luksEncryptionKeyFromRequest returns the LUKS encryption key of the volume
+// from its node stage secret, or an empty key if the volume is not LUKS
+// encrypted.
+func luksEncryptionKeyFromRequest(volumeContext, secrets map[string]string) (string, error) {
+	v, ok := volumeContext[luksEncryptionKey]
+	if !ok {
+		return "", nil
+	}
+	luksEncryption, err := strconv.ParseBool(v)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid %s: %s in volume context, it must be true or false", luksEncryptionKey, v)
+	}
+	if !luksEncryption {
+		return "", nil
+	}
+	key := secrets[luksKeySecretKey]
+	if key == "" {
+		return "", status.Errorf(codes.InvalidArgument, "LUKS encrypted volume requires the %s key in its node stage secret", luksKeySecretKey)
+	}
+	return key, nil
+}
+
+// openLUKSDevice opens the LUKS device of the volume on the device, after
+// formatting the device with LUKS if it is blank, and returns the path of the
+// LUKS device.
+func openLUKSDevice(logger *zap.SugaredLogger, mountHandler disk.Interface, volumeID, devicePath, key string) (string, error) {
+	isLUKS, err := disk.IsLUKS(devicePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to check if the device is a LUKS device.")
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	if !isLUKS {
+		existingFormat, err := mountHandler.GetDiskFormat(devicePath)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to get the disk format of the device.")
+			return "", status.Error(codes.Internal, err.Error())
+		}
+		if existingFormat != "" {
+			logger.With("existingFormat", existingFormat).Error("the volume is not blank and cannot be LUKS encrypted.")
+			return "", status.Errorf(codes.FailedPrecondition, "the volume has an existing %q format and cannot be LUKS encrypted", existingFormat)
+		}
+		if err := disk.LUKSFormat(logger, devicePath, key); err != nil {
+			logger.With(zap.Error(err)).Error("failed to format the device with LUKS.")
+			return "", status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	mapperName := disk.LUKSMapperName(volumeID)
+	if err := disk.LUKSOpen(logger, devicePath, mapperName, key); err != nil {
+		logger.With(zap.Error(err)).Error("failed to open the LUKS device.")
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return disk.LUKSMapperPath(mapperName), nil
+}
+
+// resizeLUKSDevice resizes the LUKS device of the volume, if it is open, to the
+// size of its backing device and returns the path of the device to resize the
+// filesystem of the volume on.
+func resizeLUKSDevice(logger *zap.SugaredLogger, volumeID, devicePath string) (string, error) {
+	mapperName := disk.LUKSMapperName(volumeID)
+	opened, err := disk.LUKSOpened(mapperName)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	if !opened {
+		return devicePath, nil
+	}
+	if err := disk.LUKSResize(logger, mapperName); err != nil {
+		return "", status.Errorf(codes.Internal, "Failed to resize the LUKS device of volume %q: %v", volumeID, err)
+	}
+	return disk.LUKSMapperPath(mapperName), nil
+}
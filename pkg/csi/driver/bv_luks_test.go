@@ -0,0 +1,68 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLUKSEncryptionKeyFromRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		volumeContext map[string]string
+		secrets       map[string]string
+		want          string
+		wantCode      codes.Code
+	}{
+		{
+			name: "volume without LUKS encryption",
+		},
+		{
+			name:          "volume with LUKS encryption disabled",
+			volumeContext: map[string]string{luksEncryptionKey: "false"},
+			secrets:       map[string]string{luksKeySecretKey: "key"},
+		},
+		{
+			name:          "LUKS encrypted volume",
+			volumeContext: map[string]string{luksEncryptionKey: "true"},
+			secrets:       map[string]string{luksKeySecretKey: "key"},
+			want:          "key",
+		},
+		{
+			name:          "LUKS encrypted volume without node stage secret",
+			volumeContext: map[string]string{luksEncryptionKey: "true"},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "invalid LUKS encryption",
+			volumeContext: map[string]string{luksEncryptionKey: "maybe"},
+			wantCode:      codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := luksEncryptionKeyFromRequest(tt.volumeContext, tt.secrets)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("luksEncryptionKeyFromRequest() error = %v, want code %v", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("luksEncryptionKeyFromRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -38,7 +38,7 @@ import (
 )
 
 const (
-	maxVolumesPerNode               = 32
+	defaultMaxVolumesPerNode        = 32
 	volumeOperationAlreadyExistsFmt = "An operation for the volume: %s already exists."
 	FSTypeXfs                       = "xfs"
 )
@@ -63,6 +63,12 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 
 	logger := d.logger.With("volumeID", req.VolumeId, "stagingPath", req.StagingTargetPath)
 
+	luksKey, err := luksEncryptionKeyFromRequest(req.VolumeContext, req.Secrets)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get the LUKS encryption key of the volume.")
+		return nil, err
+	}
+
 	stagingTargetFilePath := csi_util.GetPathForBlock(req.StagingTargetPath)
 
 	isRawBlockVolume := false
@@ -83,7 +89,6 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 	var devicePath string
 	var mountHandler disk.Interface
 	var scsiInfo *disk.Disk
-	var err error
 	var multipathDevices []core.MultipathDevice
 	multipathEnabledVolume := false
 
@@ -147,7 +152,14 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 	defer d.volumeLocks.Release(req.VolumeId)
 
 	if !isRawBlockVolume {
-		isMounted, oErr := mountHandler.DeviceOpened(devicePath)
+		var isMounted bool
+		var oErr error
+		if luksKey != "" {
+			// the backing device of an open LUKS device is in use even if the LUKS device is not mounted
+			isMounted, oErr = disk.LUKSDeviceOpened(logger, disk.LUKSMapperName(req.VolumeId))
+		} else {
+			isMounted, oErr = mountHandler.DeviceOpened(devicePath)
+		}
 		if oErr != nil {
 			logger.With(zap.Error(oErr)).Error("getting error to get the details about volume is already mounted or not.")
 			return nil, status.Error(codes.Internal, oErr.Error())
@@ -165,7 +177,7 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 
 	v, ok := req.PublishContext[csi_util.VpusPerGB]
 	if !ok {
-		logger.Infof("vpusPerGB not found in PublishContext %v, applying default 10 vpusPerGB", req.PublishContext)
+		logger.Info("vpusPerGB not found in PublishContext, applying default 10 vpusPerGB")
 		v = "10"
 	}
 	vpusPerGB, err := csi_util.ExtractBlockVolumePerformanceLevel(v)
@@ -211,6 +223,14 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 		return nil, status.Error(codes.DeadlineExceeded, "Failed to wait for device to exist.")
 	}
 
+	if luksKey != "" {
+		devicePath, err = openLUKSDevice(logger, mountHandler, req.VolumeId, devicePath, luksKey)
+		if err != nil {
+			return nil, err
+		}
+		logger.With("devicePath", devicePath).Info("LUKS device of the volume is open.")
+	}
+
 	if isRawBlockVolume {
 		err := csi_util.CreateFilePath(logger, stagingTargetFilePath)
 		if err != nil {
@@ -223,14 +243,28 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 			logger.With(zap.Error(err)).Error("failed to bind mount raw block volume to stagingTargetFile")
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		if err := writeStagedVolumeState(req.VolumeId, newStagedVolumeState(req.PublishContext)); err != nil {
+			logger.With(zap.Error(err)).Warn("Failed to record the state of the staged volume.")
+		}
 		return &csi.NodeStageVolumeResponse{}, nil
 	}
 
 	mnt := req.VolumeCapability.GetMount()
-	options := mnt.MountFlags
 
 	fsType := csi_util.ValidateFsType(logger, mnt.FsType)
 
+	mkfsOptions, defaultMountOptions, err := filesystemOptionsFromVolumeContext(fsType, req.VolumeContext)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid filesystem options of the volume.")
+		return nil, err
+	}
+	// the mount options of the persistent volume override the default mount options of the storage class
+	options := csi_util.MergeMountOptions(fsType, mnt.MountFlags, defaultMountOptions)
+	// the volumes of MULTI_NODE_READER_ONLY access mode are attached read-only
+	if isReadOnlyAccessMode(req.VolumeCapability) && !hasMountOption(options, "ro") {
+		options = append(options, "ro")
+	}
+
 	exists := true
 	_, err = os.Stat(req.StagingTargetPath)
 	if err != nil {
@@ -267,15 +301,29 @@ func (d BlockVolumeNodeDriver) NodeStageVolume(ctx context.Context, req *csi.Nod
 		logger.With("devicePath", devicePath, zap.Error(err)).Error("GetDiskFormatFailed")
 	}
 
+	if existingFs == disk.LUKSFormatType {
+		returnError := fmt.Sprintf("The volume is LUKS encrypted. Please stage it with a storage class or volume attributes with %s enabled.", luksEncryptionKey)
+		logger.Error(returnError)
+		return nil, status.Error(codes.FailedPrecondition, returnError)
+	}
+
 	if existingFs != "" && existingFs != fsType {
 		returnError := fmt.Sprintf("FS Type mismatch detected. The existing fs type on the volume: %q doesn't match the requested fs type: %q. Please change fs type in PV to match the existing fs type.", existingFs, fsType)
 		logger.Error(returnError)
 		return nil, status.Error(codes.Internal, returnError)
 	}
 
+	// NodeGetVolumeStats tells a read-only mount of the staging path from a
+	// filesystem remounted read-only after I/O errors with the staged state
+	stagedState := newStagedVolumeState(req.PublishContext)
+	stagedState.ReadOnly = hasMountOption(options, "ro")
+	if err := writeStagedVolumeState(req.VolumeId, stagedState); err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to record the state of the staged volume.")
+	}
+
 	logger.With("devicePath", devicePath,
-		"fsType", fsType).Info("mounting the volume to staging path.")
-	err = mountHandler.FormatAndMount(devicePath, req.StagingTargetPath, fsType, options)
+		"fsType", fsType, "mkfsOptions", mkfsOptions, "mountOptions", options).Info("mounting the volume to staging path.")
+	err = mountHandler.FormatAndMount(devicePath, req.StagingTargetPath, fsType, options, mkfsOptions)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to format and mount volume to staging path.")
 		return nil, status.Error(codes.Internal, err.Error())
@@ -332,6 +380,7 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 			// do a clean exit in case of mount point not found
 			if err == disk.ErrMountPointNotFound {
 				logger.With(zap.Error(err)).With("mountPath", req.GetStagingTargetPath()).Warn("unable to fetch mount point")
+				d.removeStagedVolumeState(logger, req.VolumeId)
 				return &csi.NodeUnstageVolumeResponse{}, nil
 			}
 			logger.With(zap.Error(err)).With("mountPath", req.GetStagingTargetPath()).Error("unable to get diskPath from mount path")
@@ -400,6 +449,11 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 		return nil, status.Error(codes.Internal, unMountErr.Error())
 	}
 
+	if err := disk.LUKSClose(logger, disk.LUKSMapperName(req.VolumeId)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to close the LUKS device")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = mountHandler.Logout()
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to logout from the iSCSI target")
@@ -412,17 +466,31 @@ func (d BlockVolumeNodeDriver) NodeUnstageVolume(ctx context.Context, req *csi.N
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	d.removeStagedVolumeState(logger, req.VolumeId)
+
 	logger.With("devicePath", devicePath, "stagingPath",
 		req.StagingTargetPath, "attachmentType", attachmentType).Info("Un-mounting the volume from staging path is completed.")
 	return &csi.NodeUnstageVolumeResponse{}, nil
 }
 
+// removeStagedVolumeState removes the state of the unstaged volume, a left
+// state is overwritten when the volume is staged again.
+func (d BlockVolumeNodeDriver) removeStagedVolumeState(logger *zap.SugaredLogger, volumeID string) {
+	if err := removeStagedVolumeState(volumeID); err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to remove the state of the unstaged volume.")
+	}
+}
+
 // NodePublishVolume mounts the volume to the target path
 func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
 	if req.VolumeId == "" {
 		return nil, status.Error(codes.InvalidArgument, "Volume ID must be provided")
 	}
 
+	if req.VolumeContext[ephemeralVolumeContextKey] == "true" {
+		return d.publishEphemeralVolume(ctx, req)
+	}
+
 	if req.PublishContext == nil || len(req.PublishContext) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "PublishContext must be provided")
 	}
@@ -512,7 +580,7 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 
 	if isRawBlockVolume {
 		options := []string{"bind"}
-		if req.Readonly {
+		if req.Readonly || isReadOnlyAccessMode(req.VolumeCapability) {
 			options = append(options, "ro")
 		}
 
@@ -532,7 +600,7 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 		options := mnt.MountFlags
 
 		options = append(options, "bind")
-		if req.Readonly {
+		if req.Readonly || isReadOnlyAccessMode(req.VolumeCapability) {
 			options = append(options, "ro")
 		}
 
@@ -623,9 +691,14 @@ func (d BlockVolumeNodeDriver) NodePublishVolume(ctx context.Context, req *csi.N
 			}
 			logger.With("devicePath", devicePath).Debug("Rescan completed")
 
+			fsDevicePath, err := resizeLUKSDevice(logger, req.VolumeId, devicePath)
+			if err != nil {
+				return nil, err
+			}
+
 			if !isRawBlockVolume {
-				if _, err := mountHandler.Resize(devicePath, req.TargetPath); err != nil {
-					return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", req.VolumeId, devicePath, err)
+				if _, err := mountHandler.Resize(fsDevicePath, req.TargetPath); err != nil {
+					return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", req.VolumeId, fsDevicePath, err)
 				}
 			}
 
@@ -659,6 +732,15 @@ func (d BlockVolumeNodeDriver) NodeUnpublishVolume(ctx context.Context, req *csi
 
 	logger := d.logger.With("volumeID", req.VolumeId, "targetPath", req.TargetPath)
 
+	ephemeralState, stateErr := readEphemeralVolumeState(req.VolumeId)
+	if stateErr != nil {
+		logger.With(zap.Error(stateErr)).Error("Failed to read the state of the ephemeral volume.")
+		return nil, status.Error(codes.Internal, stateErr.Error())
+	}
+	if ephemeralState != nil {
+		return d.unpublishEphemeralVolume(ctx, req, ephemeralState)
+	}
+
 	hostUtil := hostutil.NewHostUtil()
 	isRawBlockVolume, rbvCheckErr := hostUtil.PathIsDevice(req.TargetPath)
 
@@ -764,7 +846,7 @@ func alreadyDeletedPathCheck(err error) bool {
 // NodeGetCapabilities returns the supported capabilities of the node server
 func (d BlockVolumeNodeDriver) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	var nscaps []*csi.NodeServiceCapability
-	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_EXPAND_VOLUME}
+	nodeCaps := []csi.NodeServiceCapability_RPC_Type{csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME, csi.NodeServiceCapability_RPC_GET_VOLUME_STATS, csi.NodeServiceCapability_RPC_EXPAND_VOLUME, csi.NodeServiceCapability_RPC_VOLUME_CONDITION, csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER}
 	for _, nodeCap := range nodeCaps {
 		c := &csi.NodeServiceCapability{
 			Type: &csi.NodeServiceCapability_Rpc{
@@ -811,7 +893,7 @@ func (d BlockVolumeNodeDriver) NodeGetInfo(ctx context.Context, req *csi.NodeGet
 
 	return &csi.NodeGetInfoResponse{
 		NodeId:            d.nodeID,
-		MaxVolumesPerNode: maxVolumesPerNode,
+		MaxVolumesPerNode: d.nodeMaxVolumesPerNode(ctx, sysBlockPath),
 
 		// make sure that the driver works on this particular AD only
 		AccessibleTopology: &csi.Topology{
@@ -835,6 +917,11 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 		return nil, status.Error(codes.InvalidArgument, "volume path must be provided")
 	}
 
+	stagedState, err := readStagedVolumeState(volumeID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("Failed to read the state of the staged volume.")
+	}
+
 	hostUtil := hostutil.NewHostUtil()
 	isRawBlockVolume, rbvCheckErr := hostUtil.PathIsDevice(volumePath)
 
@@ -858,6 +945,7 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 					Total: metrics.Capacity.AsDec().UnscaledBig().Int64(),
 				},
 			},
+			VolumeCondition: d.nodeVolumeCondition(logger, volumePath, req.GetStagingTargetPath(), true, stagedState),
 		}, nil
 	}
 
@@ -893,6 +981,7 @@ func (d BlockVolumeNodeDriver) NodeGetVolumeStats(ctx context.Context, req *csi.
 				Used:      metrics.InodesUsed.AsDec().UnscaledBig().Int64(),
 			},
 		},
+		VolumeCondition: d.nodeVolumeCondition(logger, volumePath, req.GetStagingTargetPath(), false, stagedState),
 	}, nil
 }
 
@@ -983,9 +1072,14 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 	}
 	logger.With("devicePath", devicePath).Debug("Rescan completed")
 
+	fsDevicePath, err := resizeLUKSDevice(logger, volumeID, devicePath)
+	if err != nil {
+		return nil, err
+	}
+
 	if !isRawBlockVolume {
-		if _, err := mountHandler.Resize(devicePath, volumePath); err != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", volumeID, devicePath, err)
+		if _, err := mountHandler.Resize(fsDevicePath, volumePath); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to resize volume %q (%q):  %v", volumeID, fsDevicePath, err)
 		}
 	}
 
@@ -1008,6 +1102,12 @@ func (d BlockVolumeNodeDriver) NodeExpandVolume(ctx context.Context, req *csi.No
 // hasMountOption returns a boolean indicating whether the given
 // slice already contains a mount option. This is used to prevent
 // passing duplicate option to the mount command.
+// isReadOnlyAccessMode returns whether the volume capability has the
+// MULTI_NODE_READER_ONLY access mode, which uses read-only attachments.
+func isReadOnlyAccessMode(capability *csi.VolumeCapability) bool {
+	return capability.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY
+}
+
 func hasMountOption(options []string, opt string) bool {
 	for _, o := range options {
 		if o == opt {
@@ -0,0 +1,249 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// blockVolumeReplicaStatus is the status of a block volume replica in the
+// block volume replicas annotation of a persistent volume.
+type blockVolumeReplicaStatus struct {
+	ID                 string `json:"id"`
+	AvailabilityDomain string `json:"availabilityDomain"`
+	LifecycleState     string `json:"lifecycleState,omitempty"`
+	TimeLastSynced     string `json:"timeLastSynced,omitempty"`
+}
+
+// blockVolumeReplicas returns the details of the replicas of the volume in
+// the availability domains.
+func blockVolumeReplicas(volumeName string, availabilityDomains []string) []core.BlockVolumeReplicaDetails {
+	if len(availabilityDomains) == 0 {
+		return nil
+	}
+	replicas := make([]core.BlockVolumeReplicaDetails, 0, len(availabilityDomains))
+	for i := range availabilityDomains {
+		replicas = append(replicas, core.BlockVolumeReplicaDetails{
+			AvailabilityDomain: &availabilityDomains[i],
+			DisplayName:        common.String(fmt.Sprintf("%s-replica-%d", volumeName, i+1)),
+		})
+	}
+	return replicas
+}
+
+// promotableBlockVolumeReplica returns the available block volume replica of
+// the region of the cluster, to create a volume from.
+func (d *BlockVolumeControllerDriver) promotableBlockVolumeReplica(ctx context.Context, log *zap.SugaredLogger, id string) (*core.BlockVolumeReplica, error) {
+	log = log.With("blockVolumeReplicaId", id)
+	if region := d.otherRegion(id); region != "" {
+		log.With("replicaRegion", region).Error("Block volume replica of another region cannot be promoted.")
+		return nil, status.Errorf(codes.InvalidArgument, "block volume replica %s of region %s cannot be promoted in the region of the cluster", id, region)
+	}
+
+	replica, err := d.client.BlockStorage().GetBlockVolumeReplica(ctx, id)
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "get", "resource", "blockVolumeReplica", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the block volume replica.")
+		if client.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "Failed to get block volume replica with ID %v", id)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to fetch block volume replica with ID %v with error %v", id, err)
+	}
+
+	if replica.LifecycleState != core.BlockVolumeReplicaLifecycleStateAvailable {
+		log.With("lifecycleState", replica.LifecycleState).Error("Block volume replica is not available.")
+		return nil, status.Errorf(codes.FailedPrecondition, "block volume replica %s is %s, it must be %s to be promoted",
+			id, replica.LifecycleState, core.BlockVolumeReplicaLifecycleStateAvailable)
+	}
+	return replica, nil
+}
+
+// listBlockVolumeReplicaSnapshot returns the snapshot of the block volume
+// replica of a volume snapshot content to promote.
+func (d *BlockVolumeControllerDriver) listBlockVolumeReplicaSnapshot(ctx context.Context, log *zap.SugaredLogger, id, sourceVolumeID string) (*csi.ListSnapshotsResponse, error) {
+	replica, err := d.client.BlockStorage().GetBlockVolumeReplica(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return &csi.ListSnapshotsResponse{}, nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "blockVolumeReplica", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the block volume replica.")
+		return nil, status.Errorf(codes.Internal, "failed to get snapshot %s: %v", id, err)
+	}
+
+	resp := &csi.ListSnapshotsResponse{}
+	if replica.LifecycleState == core.BlockVolumeReplicaLifecycleStateTerminating ||
+		replica.LifecycleState == core.BlockVolumeReplicaLifecycleStateTerminated {
+		return resp, nil
+	}
+	snapshot := &csi.Snapshot{
+		SnapshotId: id,
+		ReadyToUse: replica.LifecycleState == core.BlockVolumeReplicaLifecycleStateAvailable,
+	}
+	if replica.BlockVolumeId != nil {
+		snapshot.SourceVolumeId = *replica.BlockVolumeId
+	}
+	if replica.SizeInGBs != nil {
+		snapshot.SizeBytes = *replica.SizeInGBs * client.GiB
+	}
+	if replica.TimeCreated != nil {
+		snapshot.CreationTime = timestamppb.New(replica.TimeCreated.Time)
+	}
+	if sourceVolumeID == "" || snapshot.SourceVolumeId == sourceVolumeID {
+		resp.Entries = append(resp.Entries, &csi.ListSnapshotsResponse_Entry{Snapshot: snapshot})
+	}
+	return resp, nil
+}
+
+// disableVolumeReplication tears down the replicas of the volume, if any.
+func (d *BlockVolumeControllerDriver) disableVolumeReplication(ctx context.Context, log *zap.SugaredLogger, volumeID string) error {
+	volume, err := d.client.BlockStorage().GetVolume(ctx, volumeID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to get the volume.")
+		return err
+	}
+	if volume == nil || len(volume.BlockVolumeReplicas) == 0 {
+		return nil
+	}
+
+	// An empty, not nil, list of replicas disables the replication
+	_, err = d.client.BlockStorage().UpdateVolume(ctx, volumeID, core.UpdateVolumeDetails{
+		BlockVolumeReplicas: []core.BlockVolumeReplicaDetails{},
+	})
+	if err != nil {
+		log.With("service", "blockstorage", "verb", "update", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+			With(zap.Error(err)).Error("Failed to disable the replication of the volume.")
+		return err
+	}
+	log.With("replicas", len(volume.BlockVolumeReplicas)).Info("Replication of the volume is disabled.")
+	return nil
+}
+
+// volumeReplicaStatuses returns the status of the replicas of the volume,
+// with the lifecycle state and the time of the last sync of the replicas that
+// could be fetched from their region.
+func (d *BlockVolumeControllerDriver) volumeReplicaStatuses(ctx context.Context, log *zap.SugaredLogger, volume *core.Volume) []blockVolumeReplicaStatus {
+	statuses := make([]blockVolumeReplicaStatus, 0, len(volume.BlockVolumeReplicas))
+	for _, info := range volume.BlockVolumeReplicas {
+		if info.BlockVolumeReplicaId == nil {
+			continue
+		}
+		replicaStatus := blockVolumeReplicaStatus{ID: *info.BlockVolumeReplicaId}
+		if info.AvailabilityDomain != nil {
+			replicaStatus.AvailabilityDomain = *info.AvailabilityDomain
+		}
+
+		blockStorage := d.client.BlockStorage()
+		var err error
+		if region := d.otherRegion(replicaStatus.ID); region != "" {
+			blockStorage, err = blockStorage.ForRegion(region)
+		}
+		var replica *core.BlockVolumeReplica
+		if err == nil {
+			replica, err = blockStorage.GetBlockVolumeReplica(ctx, replicaStatus.ID)
+		}
+		if err != nil {
+			log.With("blockVolumeReplicaId", replicaStatus.ID, "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Warn("Failed to get the block volume replica.")
+		} else {
+			replicaStatus.LifecycleState = string(replica.LifecycleState)
+			if replica.TimeLastSynced != nil {
+				replicaStatus.TimeLastSynced = replica.TimeLastSynced.Format(time.RFC3339)
+			}
+		}
+		statuses = append(statuses, replicaStatus)
+	}
+	return statuses
+}
+
+// syncVolumeReplicaAnnotations sets the block volume replicas annotation of
+// the persistent volumes of the driver whose volumes are replicated.
+func (d *BlockVolumeControllerDriver) syncVolumeReplicaAnnotations(ctx context.Context) {
+	log := d.logger.With("csiOperation", "syncVolumeReplicas")
+	pvs, err := d.KubeClient.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.With(zap.Error(err)).Error("Failed to list the persistent volumes.")
+		return
+	}
+
+	for _, pv := range pvs.Items {
+		if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != BlockVolumeDriverName ||
+			pv.Spec.CSI.VolumeAttributes[replicaAvailabilityDomainsKey] == "" {
+			continue
+		}
+		log := log.With("persistentVolume", pv.Name, "volumeID", pv.Spec.CSI.VolumeHandle)
+
+		volume, err := d.client.BlockStorage().GetVolume(ctx, pv.Spec.CSI.VolumeHandle)
+		if err != nil || volume == nil {
+			log.With("service", "blockstorage", "verb", "get", "resource", "volume", "statusCode", util.GetHttpStatusCode(err)).
+				With(zap.Error(err)).Warn("Failed to get the volume to sync the status of its replicas.")
+			continue
+		}
+
+		replicas, err := json.Marshal(d.volumeReplicaStatuses(ctx, log, volume))
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to encode the status of the block volume replicas.")
+			continue
+		}
+		if pv.Annotations[blockVolumeReplicasAnnotation] == string(replicas) {
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{blockVolumeReplicasAnnotation: string(replicas)},
+			},
+		})
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to encode the block volume replicas annotation.")
+			continue
+		}
+		if _, err = d.KubeClient.CoreV1().PersistentVolumes().Patch(ctx, pv.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			log.With(zap.Error(err)).Error("Failed to annotate the persistent volume with the block volume replicas.")
+		}
+	}
+}
+
+// runVolumeReplicaAnnotationSync syncs the block volume replicas annotations
+// every period until the stop channel is closed.
+func (d *BlockVolumeControllerDriver) runVolumeReplicaAnnotationSync(period time.Duration, stopCh <-chan struct{}) {
+	if d.KubeClient == nil {
+		return
+	}
+	wait.Until(func() {
+		d.syncVolumeReplicaAnnotations(context.Background())
+	}, period, stopCh)
+}
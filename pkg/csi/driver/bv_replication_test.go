@@ -0,0 +1,276 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubeAPI "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+const (
+	testReplicaID            = "ocid1.blockvolumereplica.oc1.iad.available"
+	testOtherRegionReplicaID = "ocid1.blockvolumereplica.oc1.phx.available"
+)
+
+// setupBlockVolumeReplicas resets the block volume replicas by region of the
+// mock block storage client.
+func setupBlockVolumeReplicas() {
+	timeLastSynced := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	regional_block_volume_replicas = map[string][]core.BlockVolumeReplica{
+		testRegion: {
+			{
+				Id:                 common.String(testReplicaID),
+				AvailabilityDomain: common.String("zkJl:US-ASHBURN-AD-2"),
+				BlockVolumeId:      common.String("ocid1.volume.oc1.iad.source"),
+				LifecycleState:     core.BlockVolumeReplicaLifecycleStateAvailable,
+				SizeInGBs:          common.Int64(50),
+				TimeCreated:        &common.SDKTime{Time: timeLastSynced},
+			},
+			{
+				Id:                 common.String("ocid1.blockvolumereplica.oc1.iad.provisioning"),
+				AvailabilityDomain: common.String("zkJl:US-ASHBURN-AD-2"),
+				BlockVolumeId:      common.String("ocid1.volume.oc1.iad.source"),
+				LifecycleState:     core.BlockVolumeReplicaLifecycleStateProvisioning,
+				SizeInGBs:          common.Int64(50),
+			},
+		},
+		testBackupSourceRegion: {
+			{
+				Id:                 common.String(testOtherRegionReplicaID),
+				AvailabilityDomain: common.String("zkJl:PHX-AD-1"),
+				BlockVolumeId:      common.String("ocid1.volume.oc1.iad.replicated"),
+				LifecycleState:     core.BlockVolumeReplicaLifecycleStateAvailable,
+				SizeInGBs:          common.Int64(50),
+				TimeLastSynced:     &common.SDKTime{Time: timeLastSynced},
+			},
+		},
+	}
+}
+
+func TestBlockVolumeReplicas(t *testing.T) {
+	if got := blockVolumeReplicas("csi-data", nil); got != nil {
+		t.Errorf("blockVolumeReplicas() = %v, want nil", got)
+	}
+
+	want := []core.BlockVolumeReplicaDetails{
+		{AvailabilityDomain: common.String("zkJl:US-ASHBURN-AD-2"), DisplayName: common.String("csi-data-replica-1")},
+		{AvailabilityDomain: common.String("zkJl:PHX-AD-1"), DisplayName: common.String("csi-data-replica-2")},
+	}
+	if got := blockVolumeReplicas("csi-data", []string{"zkJl:US-ASHBURN-AD-2", "zkJl:PHX-AD-1"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("blockVolumeReplicas() = %v, want %v", got, want)
+	}
+}
+
+func TestBlockVolumeControllerDriver_promotableBlockVolumeReplica(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantCode codes.Code
+	}{
+		{
+			name:     "available replica",
+			id:       testReplicaID,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "replica of another region",
+			id:       testOtherRegionReplicaID,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "replica not found",
+			id:       "ocid1.blockvolumereplica.oc1.iad.missing",
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "replica not available yet",
+			id:       "ocid1.blockvolumereplica.oc1.iad.provisioning",
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupBlockVolumeReplicas()
+			d := newGroupControllerDriver()
+			d.config.Auth.Region = testRegion
+
+			replica, err := d.promotableBlockVolumeReplica(context.Background(), zap.S(), tt.id)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("promotableBlockVolumeReplica() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && *replica.Id != tt.id {
+				t.Errorf("promotableBlockVolumeReplica() = %s, want %s", *replica.Id, tt.id)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_listBlockVolumeReplicaSnapshot(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		sourceVolumeID string
+		wantEntries    int
+		wantReady      bool
+	}{
+		{
+			name:        "available replica",
+			id:          testReplicaID,
+			wantEntries: 1,
+			wantReady:   true,
+		},
+		{
+			name:        "replica not available yet",
+			id:          "ocid1.blockvolumereplica.oc1.iad.provisioning",
+			wantEntries: 1,
+		},
+		{
+			name: "replica not found",
+			id:   "ocid1.blockvolumereplica.oc1.iad.missing",
+		},
+		{
+			name:           "replica of another source volume",
+			id:             testReplicaID,
+			sourceVolumeID: "ocid1.volume.oc1.iad.other",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupBlockVolumeReplicas()
+			d := newGroupControllerDriver()
+
+			resp, err := d.listBlockVolumeReplicaSnapshot(context.Background(), zap.S(), tt.id, tt.sourceVolumeID)
+			if err != nil {
+				t.Fatalf("listBlockVolumeReplicaSnapshot() error = %v", err)
+			}
+			if len(resp.Entries) != tt.wantEntries {
+				t.Fatalf("listBlockVolumeReplicaSnapshot() returned %d entries, want %d", len(resp.Entries), tt.wantEntries)
+			}
+			if tt.wantEntries == 0 {
+				return
+			}
+			snapshot := resp.Entries[0].Snapshot
+			if snapshot.SnapshotId != tt.id || snapshot.ReadyToUse != tt.wantReady || snapshot.SizeBytes != 50*client.GiB {
+				t.Errorf("listBlockVolumeReplicaSnapshot() = %v, want ready %v", snapshot, tt.wantReady)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_disableVolumeReplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		replicas    []core.BlockVolumeReplicaInfo
+		wantUpdated bool
+	}{
+		{
+			name: "volume without replicas",
+		},
+		{
+			name: "replicated volume",
+			replicas: []core.BlockVolumeReplicaInfo{
+				{BlockVolumeReplicaId: common.String(testOtherRegionReplicaID), AvailabilityDomain: common.String("zkJl:PHX-AD-1")},
+			},
+			wantUpdated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			volumeID := "ocid1.volume.oc1.iad.replicated"
+			volumes[volumeID] = &core.Volume{
+				Id:                  common.String(volumeID),
+				LifecycleState:      core.VolumeLifecycleStateAvailable,
+				BlockVolumeReplicas: tt.replicas,
+			}
+			defer delete(volumes, volumeID)
+			updated_volumes = map[string]core.UpdateVolumeDetails{}
+
+			d := newGroupControllerDriver()
+			if err := d.disableVolumeReplication(context.Background(), zap.S(), volumeID); err != nil {
+				t.Fatalf("disableVolumeReplication() error = %v", err)
+			}
+			details, updated := updated_volumes[volumeID]
+			if updated != tt.wantUpdated {
+				t.Fatalf("disableVolumeReplication() updated the volume = %v, want %v", updated, tt.wantUpdated)
+			}
+			if updated && (details.BlockVolumeReplicas == nil || len(details.BlockVolumeReplicas) != 0) {
+				t.Errorf("disableVolumeReplication() updated the replicas to %v, want an empty list", details.BlockVolumeReplicas)
+			}
+		})
+	}
+}
+
+func TestBlockVolumeControllerDriver_syncVolumeReplicaAnnotations(t *testing.T) {
+	setupBlockVolumeReplicas()
+	volumeID := "ocid1.volume.oc1.iad.replicated"
+	volumes[volumeID] = &core.Volume{
+		Id:             common.String(volumeID),
+		LifecycleState: core.VolumeLifecycleStateAvailable,
+		BlockVolumeReplicas: []core.BlockVolumeReplicaInfo{
+			{BlockVolumeReplicaId: common.String(testOtherRegionReplicaID), AvailabilityDomain: common.String("zkJl:PHX-AD-1")},
+		},
+	}
+	defer delete(volumes, volumeID)
+
+	persistentVolume := func(name, driver string, attributes map[string]string) *kubeAPI.PersistentVolume {
+		return &kubeAPI.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kubeAPI.PersistentVolumeSpec{
+				PersistentVolumeSource: kubeAPI.PersistentVolumeSource{
+					CSI: &kubeAPI.CSIPersistentVolumeSource{Driver: driver, VolumeHandle: volumeID, VolumeAttributes: attributes},
+				},
+			},
+		}
+	}
+	kubeClient := fake.NewSimpleClientset(
+		persistentVolume("replicated", BlockVolumeDriverName, map[string]string{replicaAvailabilityDomainsKey: "zkJl:PHX-AD-1"}),
+		persistentVolume("not-replicated", BlockVolumeDriverName, map[string]string{}),
+		persistentVolume("other-driver", FSSDriverName, map[string]string{replicaAvailabilityDomainsKey: "zkJl:PHX-AD-1"}),
+	)
+	d := newGroupControllerDriver()
+	d.KubeClient = kubeClient
+	d.config.Auth.Region = testRegion
+
+	d.syncVolumeReplicaAnnotations(context.Background())
+
+	want := map[string]string{
+		"replicated": `[{"id":"` + testOtherRegionReplicaID + `","availabilityDomain":"zkJl:PHX-AD-1",` +
+			`"lifecycleState":"AVAILABLE","timeLastSynced":"2026-10-01T12:00:00Z"}]`,
+		"not-replicated": "",
+		"other-driver":   "",
+	}
+	for name, wantAnnotation := range want {
+		pv, err := kubeClient.CoreV1().PersistentVolumes().Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get persistent volume %s: %v", name, err)
+		}
+		if got := pv.Annotations[blockVolumeReplicasAnnotation]; got != wantAnnotation {
+			t.Errorf("persistent volume %s annotation = %s, want %s", name, got, wantAnnotation)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+	"github.com/pkg/errors"
+)
+
+// stagedVolumeState is the state of a volume staged on the node, recorded
+// when the volume is staged for the RPCs which are not given the volume
+// capability or the publish context, such as NodeGetVolumeStats.
+type stagedVolumeState struct {
+	// ReadOnly is whether the filesystem of the volume is mounted read-only
+	// at the staging path on purpose, e.g. for MULTI_NODE_READER_ONLY volumes
+	ReadOnly bool `json:"readOnly"`
+	// PerformanceTier is the performance tier of the volume when it was
+	// published, from the publish context
+	PerformanceTier map[string]string `json:"performanceTier,omitempty"`
+	// ISCSITargets are the IQNs of the iSCSI targets of the volume attachment
+	// the volume was staged from, the stale attachment clean up only cleans up
+	// these targets
+	ISCSITargets []string `json:"iscsiTargets,omitempty"`
+}
+
+// newStagedVolumeState returns the state of a volume staged with the publish
+// context.
+func newStagedVolumeState(publishContext map[string]string) *stagedVolumeState {
+	state := &stagedVolumeState{ISCSITargets: publishContextIQNs(publishContext)}
+	for _, key := range []string{csi_util.VpusPerGB, autoTunedVpusPerGBKey, detachedAutotuneKey, maxVpusPerGBKey} {
+		if value, ok := publishContext[key]; ok {
+			if state.PerformanceTier == nil {
+				state.PerformanceTier = map[string]string{}
+			}
+			state.PerformanceTier[key] = value
+		}
+	}
+	return state
+}
+
+// performanceTierMessage describes the performance tier of the staged volume,
+// empty if it is unknown.
+func (s *stagedVolumeState) performanceTierMessage() string {
+	if s == nil || s.PerformanceTier[csi_util.VpusPerGB] == "" {
+		return ""
+	}
+	tier := s.PerformanceTier
+	message := fmt.Sprintf("performance tier: %s vpusPerGB", tier[csi_util.VpusPerGB])
+	if autoTuned := tier[autoTunedVpusPerGBKey]; autoTuned != "" && autoTuned != tier[csi_util.VpusPerGB] {
+		message += fmt.Sprintf(", autotuned to %s vpusPerGB", autoTuned)
+	}
+	if tier[detachedAutotuneKey] == "true" {
+		message += ", detached volume autotune enabled"
+	}
+	if maxVpusPerGB := tier[maxVpusPerGBKey]; maxVpusPerGB != "" && maxVpusPerGB != "0" {
+		message += fmt.Sprintf(", performance based autotune up to %s vpusPerGB", maxVpusPerGB)
+	}
+	return message
+}
+
+func stagedVolumeDir() string {
+	return filepath.Join(kubeletPluginsDir, BlockVolumeDriverName, "staged")
+}
+
+func stagedVolumeStatePath(volumeID string) string {
+	return filepath.Join(stagedVolumeDir(), filepath.Base(volumeID)+".json")
+}
+
+// readStagedVolumeState returns the state of the volume staged on the node,
+// nil if no state is recorded, e.g. for volumes staged by older versions of
+// the driver.
+func readStagedVolumeState(volumeID string) (*stagedVolumeState, error) {
+	data, err := os.ReadFile(stagedVolumeStatePath(volumeID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	state := &stagedVolumeState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "invalid state of staged volume %s", volumeID)
+	}
+	return state, nil
+}
+
+// readStagedIQNs returns the IQNs of the iSCSI targets of the volumes staged
+// on the node.
+func readStagedIQNs() (map[string]bool, error) {
+	iqns := make(map[string]bool)
+	entries, err := os.ReadDir(stagedVolumeDir())
+	if os.IsNotExist(err) {
+		return iqns, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		volumeID, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		state, err := readStagedVolumeState(volumeID)
+		if err != nil {
+			return nil, err
+		}
+		for _, iqn := range state.ISCSITargets {
+			iqns[iqn] = true
+		}
+	}
+	return iqns, nil
+}
+
+func writeStagedVolumeState(volumeID string, state *stagedVolumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(stagedVolumeDir(), 0750); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(stagedVolumeStatePath(volumeID), data, 0640))
+}
+
+func removeStagedVolumeState(volumeID string) error {
+	if err := os.Remove(stagedVolumeStatePath(volumeID)); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
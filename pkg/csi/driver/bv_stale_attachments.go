@@ -0,0 +1,339 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/mount-utils"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+const (
+	// kubeletPodsDir is the directory of the kubelet the volumes are published
+	// to the pods in
+	kubeletPodsDir = "/var/lib/kubelet/pods/"
+	// kubeletCSIPluginDir is the directory of the kubelet the CSI volumes are
+	// staged in
+	kubeletCSIPluginDir = "/var/lib/kubelet/plugins/kubernetes.io/csi/"
+	// ociVolumeIQNPrefix is the prefix of the IQNs of the iSCSI targets of the
+	// block volume attachments
+	ociVolumeIQNPrefix = "iqn.2015-12.com.oracleiaas:"
+)
+
+// nodeAttachmentState is the state of the iSCSI attachments of the node.
+type nodeAttachmentState struct {
+	// sessions are the iSCSI sessions of the node
+	sessions []disk.ISCSISession
+	// nodeRecords are the targets of the iSCSI node records of the node
+	nodeRecords []disk.Disk
+	// targetDisks are the SCSI disks of the block volume iSCSI targets, by
+	// target
+	targetDisks map[string][]string
+	// multipathSlaves are the SCSI disks of the device mapper devices, by
+	// device
+	multipathSlaves map[string][]string
+	// mounts are the mounts of the kubelet directories, whose device is the
+	// kernel name of the device mounted
+	mounts []mount.MountPoint
+}
+
+// staleAttachmentCleanup are the actions removing the leftovers of the iSCSI
+// attachments of block volumes detached from the node.
+type staleAttachmentCleanup struct {
+	// unmountPaths are the publish paths and then the staging paths to unmount
+	unmountPaths []string
+	// multipathDevices are the device mapper devices of the multipath maps to
+	// flush
+	multipathDevices []string
+	// logoutTargets are the iSCSI targets to log out of
+	logoutTargets []disk.Disk
+	// removeTargets are the iSCSI targets to remove from the node records
+	removeTargets []disk.Disk
+}
+
+func (c staleAttachmentCleanup) empty() bool {
+	return len(c.unmountPaths) == 0 && len(c.multipathDevices) == 0 && len(c.logoutTargets) == 0 && len(c.removeTargets) == 0
+}
+
+// runStaleAttachmentReconciler cleans up the leftovers of the iSCSI
+// attachments of block volumes detached from the node at startup and then
+// every period, or only logs the clean up actions in dry run mode.
+func (d BlockVolumeNodeDriver) runStaleAttachmentReconciler(period time.Duration, dryRun bool, stopCh <-chan struct{}) {
+	if d.KubeClient == nil {
+		return
+	}
+	wait.Until(func() {
+		d.reconcileStaleAttachments(context.Background(), dryRun)
+	}, period, stopCh)
+}
+
+// reconcileStaleAttachments cleans up the leftovers of the iSCSI attachments
+// of block volumes detached from the node, or only logs the clean up actions
+// in dry run mode.
+func (d BlockVolumeNodeDriver) reconcileStaleAttachments(ctx context.Context, dryRun bool) {
+	logger := d.logger.With("nodeId", d.nodeID, "dryRun", dryRun)
+
+	// The state of the node is read before the volume attachments, so that the
+	// volume attachments of all the sessions and node records read are listed
+	state, err := readNodeAttachmentState(logger)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to read the iSCSI attachments of the node, skipping the stale attachment clean up.")
+		return
+	}
+	volumeAttachments, err := d.KubeClient.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to list volume attachments, skipping the stale attachment clean up.")
+		return
+	}
+
+	stagedIQNs, err := readStagedIQNs()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to read the staged volumes, skipping the stale attachment clean up.")
+		return
+	}
+
+	cleanup := planStaleAttachmentCleanup(state, attachedIQNs(volumeAttachments.Items, d.nodeID), stagedIQNs)
+	if cleanup.empty() {
+		logger.Debug("No stale attachment found.")
+		return
+	}
+	if dryRun {
+		logger.With("unmountPaths", cleanup.unmountPaths, "multipathDevices", cleanup.multipathDevices,
+			"logoutTargets", cleanup.logoutTargets, "removeTargets", cleanup.removeTargets).
+			Info("Dry run: stale attachments would be cleaned up.")
+		return
+	}
+
+	// Every action is attempted, an action failing because of a previous one is
+	// retried at the next reconciliation
+	mounter := disk.NewFromPVDisk(d.logger)
+	for _, path := range cleanup.unmountPaths {
+		if err := mounter.UnmountPath(path); err != nil {
+			logger.With(zap.Error(err)).With("path", path).Error("Failed to unmount stale mount.")
+			continue
+		}
+		logger.With("path", path).Info("Unmounted stale mount.")
+	}
+	for _, device := range cleanup.multipathDevices {
+		if err := disk.FlushMultipathDevice(d.logger, device); err != nil {
+			logger.With(zap.Error(err)).With("device", device).Error("Failed to flush stale multipath device.")
+			continue
+		}
+		logger.With("device", device).Info("Flushed stale multipath device.")
+	}
+	for _, target := range cleanup.logoutTargets {
+		if err := disk.NewFromISCSIDisk(d.logger, &target).Logout(); err != nil {
+			logger.With(zap.Error(err)).With("target", target.String()).Error("Failed to log out of stale iSCSI target.")
+		}
+	}
+	for _, target := range cleanup.removeTargets {
+		if err := disk.NewFromISCSIDisk(d.logger, &target).RemoveFromDB(); err != nil {
+			logger.With(zap.Error(err)).With("target", target.String()).Error("Failed to remove stale iSCSI node record.")
+		}
+	}
+}
+
+// attachedIQNs returns the IQNs of the iSCSI targets of the volume attachments
+// of the node by the block volume CSI driver, whether attached yet or not.
+func attachedIQNs(volumeAttachments []storagev1.VolumeAttachment, nodeID string) map[string]bool {
+	iqns := make(map[string]bool)
+	for _, volumeAttachment := range volumeAttachments {
+		if volumeAttachment.Spec.Attacher != BlockVolumeDriverName || volumeAttachment.Spec.NodeName != nodeID {
+			continue
+		}
+		for _, iqn := range publishContextIQNs(volumeAttachment.Status.AttachmentMetadata) {
+			iqns[iqn] = true
+		}
+	}
+	return iqns
+}
+
+// publishContextIQNs returns the IQNs of the iSCSI targets of a volume
+// attachment, from its publish context.
+func publishContextIQNs(publishContext map[string]string) []string {
+	var iqns []string
+	if iqn := publishContext[disk.ISCSIIQN]; iqn != "" {
+		iqns = append(iqns, iqn)
+	}
+	var devices []core.MultipathDevice
+	if err := json.Unmarshal([]byte(publishContext[multipathDevices]), &devices); err == nil {
+		for _, device := range devices {
+			if device.Iqn != nil {
+				iqns = append(iqns, *device.Iqn)
+			}
+		}
+	}
+	return iqns
+}
+
+// planStaleAttachmentCleanup returns the actions removing the leftovers of the
+// iSCSI attachments of block volumes detached from the node. Only the block
+// volume iSCSI targets staged by the driver and not referenced by any volume
+// attachment of the node are cleaned up, so that the targets logged in by
+// others are left alone, if their sessions are not logged in, which happens
+// once the volume is detached, or they have no session nor disk:
+//   - the mounts of their disks, and of the multipath devices of their disks,
+//     in the kubelet directories,
+//   - the multipath devices whose disks are all disks of these targets,
+//   - their sessions and their node records.
+func planStaleAttachmentCleanup(state *nodeAttachmentState, attachedIQNs, stagedIQNs map[string]bool) staleAttachmentCleanup {
+	var cleanup staleAttachmentCleanup
+	candidate := func(target disk.Disk) bool {
+		return strings.HasPrefix(target.IQN, ociVolumeIQNPrefix) && stagedIQNs[target.IQN] && !attachedIQNs[target.IQN]
+	}
+
+	staleDisks := make(map[string]bool)
+	// sessionTargets are the targets with a session, true if it is stale
+	sessionTargets := make(map[string]bool)
+	for _, session := range state.sessions {
+		stale := candidate(session.Disk) && session.State != disk.ISCSISessionStateLoggedIn
+		sessionTargets[session.String()] = stale
+		if !stale {
+			continue
+		}
+		cleanup.logoutTargets = append(cleanup.logoutTargets, session.Disk)
+		for _, device := range state.targetDisks[session.String()] {
+			staleDisks[device] = true
+		}
+	}
+	for _, target := range state.nodeRecords {
+		if !candidate(target) {
+			continue
+		}
+		stale, hasSession := sessionTargets[target.String()]
+		if stale || (!hasSession && len(state.targetDisks[target.String()]) == 0) {
+			cleanup.removeTargets = append(cleanup.removeTargets, target)
+		}
+	}
+
+	staleDevices := make(map[string]bool)
+	for device := range staleDisks {
+		staleDevices[device] = true
+	}
+	for device, slaves := range state.multipathSlaves {
+		stale := len(slaves) > 0
+		for _, slave := range slaves {
+			stale = stale && staleDisks[slave]
+		}
+		if stale {
+			staleDevices[device] = true
+			cleanup.multipathDevices = append(cleanup.multipathDevices, device)
+		}
+	}
+	sort.Strings(cleanup.multipathDevices)
+
+	var publishPaths, stagingPaths []string
+	for _, mountPoint := range state.mounts {
+		if !staleDevices[mountPoint.Device] {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(mountPoint.Path, kubeletPodsDir):
+			publishPaths = append(publishPaths, mountPoint.Path)
+		case strings.HasPrefix(mountPoint.Path, kubeletCSIPluginDir):
+			stagingPaths = append(stagingPaths, mountPoint.Path)
+		}
+	}
+	sort.Strings(publishPaths)
+	sort.Strings(stagingPaths)
+	cleanup.unmountPaths = append(publishPaths, stagingPaths...)
+	return cleanup
+}
+
+// readNodeAttachmentState reads the iSCSI sessions and node records of the
+// node, the disks of the block volume iSCSI targets, the multipath devices and
+// the mounts of the kubelet directories.
+func readNodeAttachmentState(logger *zap.SugaredLogger) (*nodeAttachmentState, error) {
+	sessions, err := disk.ListISCSISessions(logger)
+	if err != nil {
+		return nil, err
+	}
+	nodeRecords, err := disk.ListISCSINodeRecords(logger)
+	if err != nil {
+		return nil, err
+	}
+	state := &nodeAttachmentState{
+		sessions:        sessions,
+		nodeRecords:     nodeRecords,
+		targetDisks:     make(map[string][]string),
+		multipathSlaves: make(map[string][]string),
+	}
+
+	targets := append([]disk.Disk{}, nodeRecords...)
+	for _, session := range sessions {
+		targets = append(targets, session.Disk)
+	}
+	for i := range targets {
+		if !strings.HasPrefix(targets[i].IQN, ociVolumeIQNPrefix) {
+			continue
+		}
+		if _, ok := state.targetDisks[targets[i].String()]; ok {
+			continue
+		}
+		devices, err := disk.ISCSIDiskDevices(&targets[i])
+		if err != nil {
+			return nil, err
+		}
+		state.targetDisks[targets[i].String()] = devices
+	}
+
+	entries, err := os.ReadDir(sysBlockPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "dm-") {
+			continue
+		}
+		slaves, err := os.ReadDir(filepath.Join(sysBlockPath, entry.Name(), "slaves"))
+		if err != nil {
+			return nil, err
+		}
+		for _, slave := range slaves {
+			state.multipathSlaves[entry.Name()] = append(state.multipathSlaves[entry.Name()], slave.Name())
+		}
+	}
+
+	mountPoints, err := mount.ListProcMounts(procMountsPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, mountPoint := range mountPoints {
+		if !strings.HasPrefix(mountPoint.Device, "/dev/") ||
+			!(strings.HasPrefix(mountPoint.Path, kubeletPodsDir) || strings.HasPrefix(mountPoint.Path, kubeletCSIPluginDir)) {
+			continue
+		}
+		device, err := filepath.EvalSymlinks(mountPoint.Device)
+		if err != nil {
+			continue
+		}
+		mountPoint.Device = filepath.Base(device)
+		state.mounts = append(state.mounts, mountPoint)
+	}
+	return state, nil
+}
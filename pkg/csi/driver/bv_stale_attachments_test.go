@@ -0,0 +1,166 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"reflect"
+	"testing"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/mount-utils"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+func TestAttachedIQNs(t *testing.T) {
+	volumeAttachment := func(attacher, node string, metadata map[string]string) storagev1.VolumeAttachment {
+		return storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: "csi-" + node},
+			Spec:       storagev1.VolumeAttachmentSpec{Attacher: attacher, NodeName: node},
+			Status:     storagev1.VolumeAttachmentStatus{AttachmentMetadata: metadata},
+		}
+	}
+	volumeAttachments := []storagev1.VolumeAttachment{
+		volumeAttachment(BlockVolumeDriverName, "node1", map[string]string{disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:iscsi"}),
+		volumeAttachment(BlockVolumeDriverName, "node1", map[string]string{
+			disk.ISCSIIQN:    "iqn.2015-12.com.oracleiaas:uhp",
+			multipathDevices: `[{"iqn":"iqn.2015-12.com.oracleiaas:uhp-2","ipv4":"169.254.2.3","port":3260}]`,
+		}),
+		volumeAttachment(BlockVolumeDriverName, "node1", map[string]string{attachmentType: attachmentTypeParavirtualized}),
+		volumeAttachment(BlockVolumeDriverName, "node1", nil),
+		volumeAttachment(BlockVolumeDriverName, "node2", map[string]string{disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:other-node"}),
+		volumeAttachment(FSSDriverName, "node1", map[string]string{disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:other-driver"}),
+	}
+
+	want := map[string]bool{
+		"iqn.2015-12.com.oracleiaas:iscsi": true,
+		"iqn.2015-12.com.oracleiaas:uhp":   true,
+		"iqn.2015-12.com.oracleiaas:uhp-2": true,
+	}
+	if got := attachedIQNs(volumeAttachments, "node1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("attachedIQNs() = %v, want %v", got, want)
+	}
+}
+
+func TestPlanStaleAttachmentCleanup(t *testing.T) {
+	target := func(iqn, ip string) disk.Disk {
+		return disk.Disk{IQN: iqn, IscsiIp: ip, Port: 3260}
+	}
+	boot := target("iqn.2015-02.oracle.boot:uefi", "169.254.0.2")
+	attached := target("iqn.2015-12.com.oracleiaas:attached", "169.254.2.2")
+	detached := target("iqn.2015-12.com.oracleiaas:detached", "169.254.2.3")
+	detachedUHP1 := target("iqn.2015-12.com.oracleiaas:detached-uhp", "169.254.2.4")
+	detachedUHP2 := target("iqn.2015-12.com.oracleiaas:detached-uhp", "169.254.2.5")
+	manual := target("iqn.2015-12.com.oracleiaas:manual", "169.254.2.6")
+	leftoverRecord := target("iqn.2015-12.com.oracleiaas:leftover", "169.254.2.7")
+	unattachedRecord := target("iqn.2015-12.com.oracleiaas:unattached", "169.254.2.8")
+	unstaged := target("iqn.2015-12.com.oracleiaas:unstaged", "169.254.2.9")
+
+	state := &nodeAttachmentState{
+		sessions: []disk.ISCSISession{
+			{Disk: boot, State: disk.ISCSISessionStateLoggedIn},
+			{Disk: attached, State: "FAILED"},
+			{Disk: detached, State: "FAILED"},
+			{Disk: detachedUHP1, State: "FAILED"},
+			{Disk: detachedUHP2, State: "FAILED"},
+			{Disk: manual, State: disk.ISCSISessionStateLoggedIn},
+			{Disk: unstaged, State: "FAILED"},
+		},
+		nodeRecords: []disk.Disk{boot, attached, detached, manual, leftoverRecord, unattachedRecord, unstaged},
+		targetDisks: map[string][]string{
+			attached.String():         {"sdb"},
+			detached.String():         {"sdc"},
+			detachedUHP1.String():     {"sdd"},
+			detachedUHP2.String():     {"sde"},
+			manual.String():           {"sdf"},
+			leftoverRecord.String():   {},
+			unattachedRecord.String(): {"sdg"},
+			unstaged.String():         {"sdi"},
+		},
+		multipathSlaves: map[string][]string{
+			"dm-0": {"sdd", "sde"},
+			"dm-1": {"sdf"},
+			"dm-2": {"sdc", "sdh"},
+		},
+		mounts: []mount.MountPoint{
+			{Device: "sdb", Path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/attached/globalmount"},
+			{Device: "sdc", Path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/detached/globalmount"},
+			{Device: "sdc", Path: "/var/lib/kubelet/pods/pod1/volumes/kubernetes.io~csi/detached/mount"},
+			{Device: "sdc", Path: "/mnt/detached"},
+			{Device: "dm-0", Path: "/var/lib/kubelet/plugins/kubernetes.io/csi/pv/detached-uhp/globalmount"},
+			{Device: "sdf", Path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/manual/globalmount"},
+			{Device: "sdi", Path: "/var/lib/kubelet/pods/pod2/volumes/kubernetes.io~csi/unstaged/mount"},
+		},
+	}
+	attachedIQNs := map[string]bool{attached.IQN: true}
+	// the targets logged in by others than the driver, like unstaged, are not staged
+	stagedIQNs := map[string]bool{
+		attached.IQN: true, detached.IQN: true, detachedUHP1.IQN: true, manual.IQN: true, leftoverRecord.IQN: true, unattachedRecord.IQN: true,
+	}
+
+	want := staleAttachmentCleanup{
+		unmountPaths: []string{
+			"/var/lib/kubelet/pods/pod1/volumes/kubernetes.io~csi/detached/mount",
+			"/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/detached/globalmount",
+			"/var/lib/kubelet/plugins/kubernetes.io/csi/pv/detached-uhp/globalmount",
+		},
+		multipathDevices: []string{"dm-0"},
+		logoutTargets:    []disk.Disk{detached, detachedUHP1, detachedUHP2},
+		removeTargets:    []disk.Disk{detached, leftoverRecord},
+	}
+	got := planStaleAttachmentCleanup(state, attachedIQNs, stagedIQNs)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("planStaleAttachmentCleanup() = %+v, want %+v", got, want)
+	}
+
+	if got := planStaleAttachmentCleanup(&nodeAttachmentState{sessions: state.sessions[:2]}, attachedIQNs, stagedIQNs); !got.empty() {
+		t.Errorf("planStaleAttachmentCleanup() = %+v, want no action", got)
+	}
+	if got := planStaleAttachmentCleanup(state, attachedIQNs, nil); !got.empty() {
+		t.Errorf("planStaleAttachmentCleanup() = %+v, want no action", got)
+	}
+}
+
+func TestReadStagedIQNs(t *testing.T) {
+	defer func(dir string) { kubeletPluginsDir = dir }(kubeletPluginsDir)
+	kubeletPluginsDir = t.TempDir()
+
+	if got, err := readStagedIQNs(); err != nil || len(got) != 0 {
+		t.Fatalf("readStagedIQNs() without staged volume = %v, %v, want none", got, err)
+	}
+	states := map[string]map[string]string{
+		"ocid1.volume.oc1.iscsi": {disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:iscsi"},
+		"ocid1.volume.oc1.uhp": {
+			disk.ISCSIIQN:    "iqn.2015-12.com.oracleiaas:uhp",
+			multipathDevices: `[{"iqn":"iqn.2015-12.com.oracleiaas:uhp-2","ipv4":"169.254.2.3","port":3260}]`,
+		},
+		"ocid1.volume.oc1.pv": {attachmentType: attachmentTypeParavirtualized},
+	}
+	for volumeID, publishContext := range states {
+		if err := writeStagedVolumeState(volumeID, newStagedVolumeState(publishContext)); err != nil {
+			t.Fatalf("writeStagedVolumeState() failed: %v", err)
+		}
+	}
+
+	want := map[string]bool{
+		"iqn.2015-12.com.oracleiaas:iscsi": true,
+		"iqn.2015-12.com.oracleiaas:uhp":   true,
+		"iqn.2015-12.com.oracleiaas:uhp-2": true,
+	}
+	if got, err := readStagedIQNs(); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("readStagedIQNs() = %v, %v, want %v", got, err, want)
+	}
+}
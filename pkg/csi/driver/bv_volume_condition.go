@@ -0,0 +1,216 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"k8s.io/mount-utils"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+const (
+	// procMountsPath is the mount table of the node driver
+	procMountsPath = "/proc/mounts"
+)
+
+// volumeCondition returns the condition of a volume from its lifecycle state
+// and its attachments, described by the names of the nodes of their instances.
+// The volume is abnormal if it is faulty or terminating, or if an iSCSI
+// attachment failed to log in or out of its target.
+func volumeCondition(lifecycleState string, attachments []core.VolumeAttachment, nodeNames map[string]string) *csi.VolumeCondition {
+	abnormal := lifecycleState == string(core.VolumeLifecycleStateFaulty) ||
+		lifecycleState == string(core.VolumeLifecycleStateTerminating)
+
+	descriptions := []string{fmt.Sprintf("volume is %s", lifecycleState)}
+	for _, attachment := range attachments {
+		instance := "unknown instance"
+		if id := attachment.GetInstanceId(); id != nil {
+			instance = *id
+			if nodeName, ok := nodeNames[*id]; ok {
+				instance = "node " + nodeName
+			}
+		}
+		description := fmt.Sprintf("attachment to %s is %s", instance, attachment.GetLifecycleState())
+		switch loginState := attachment.GetIscsiLoginState(); loginState {
+		case core.VolumeAttachmentIscsiLoginStateLoginFailed, core.VolumeAttachmentIscsiLoginStateLogoutFailed:
+			abnormal = true
+			description += fmt.Sprintf(" with iSCSI login state %s", loginState)
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	return &csi.VolumeCondition{
+		Abnormal: abnormal,
+		Message:  strings.Join(descriptions, ", "),
+	}
+}
+
+// mountedReadOnly returns true if the path is mounted read-only in the mount
+// table. The mount table reports the mounts of filesystems remounted read-only
+// after I/O errors as read-only.
+func mountedReadOnly(mountsPath, path string) (bool, error) {
+	mountPoints, err := mount.ListProcMounts(mountsPath)
+	if err != nil {
+		return false, err
+	}
+	for _, mountPoint := range mountPoints {
+		if mountPoint.Path != path {
+			continue
+		}
+		for _, opt := range mountPoint.Opts {
+			if opt == "ro" {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, disk.ErrMountPointNotFound
+}
+
+// stagingReadOnlyCondition returns an abnormal volume condition if the
+// filesystem of the volume is mounted read-only at the staging path in the
+// mount table while the staging path is not mounted read-only on purpose, as
+// for MULTI_NODE_READER_ONLY volumes. It returns nil otherwise.
+func stagingReadOnlyCondition(logger *zap.SugaredLogger, mountsPath, stagingTargetPath string, readOnly bool) *csi.VolumeCondition {
+	if readOnly || stagingTargetPath == "" {
+		return nil
+	}
+	mountedRO, err := mountedReadOnly(mountsPath, stagingTargetPath)
+	if err != nil {
+		logger.With(zap.Error(err)).With("stagingTargetPath", stagingTargetPath).Warn("Failed to check if the staging path is mounted read-only.")
+		return nil
+	}
+	if !mountedRO {
+		return nil
+	}
+	return &csi.VolumeCondition{
+		Abnormal: true,
+		Message:  fmt.Sprintf("filesystem of the volume is mounted read-only at %s, it may have been remounted read-only after I/O errors", stagingTargetPath),
+	}
+}
+
+// volumeHealthCondition returns an abnormal volume condition if the volume
+// published at the volume path is unhealthy on the node: its filesystem is
+// unexpectedly mounted read-only at the staging path, the iSCSI session of
+// its attachment is logged out, or its multipath device or some of its paths
+// are missing or failed. readOnly is whether the staging path is mounted
+// read-only on purpose. It returns nil if the volume is healthy or its health
+// is unknown.
+func (d BlockVolumeNodeDriver) volumeHealthCondition(logger *zap.SugaredLogger, volumePath, stagingTargetPath string, isRawBlockVolume, readOnly bool) *csi.VolumeCondition {
+	abnormal := func(format string, args ...interface{}) *csi.VolumeCondition {
+		return &csi.VolumeCondition{Abnormal: true, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if !isRawBlockVolume {
+		if condition := stagingReadOnlyCondition(logger, procMountsPath, stagingTargetPath, readOnly); condition != nil {
+			return condition
+		}
+	}
+
+	var (
+		diskPath []string
+		err      error
+	)
+	if isRawBlockVolume {
+		diskPath, err = disk.GetDiskPathFromBindDeviceFilePath(logger, volumePath)
+	} else {
+		diskPath, err = disk.GetDiskPathFromMountPath(logger, volumePath)
+	}
+	if err != nil {
+		if err == disk.ErrMountPointNotFound {
+			return abnormal("volume is not mounted at %s", volumePath)
+		}
+		logger.With(zap.Error(err)).Warn("Failed to get the disk paths of the volume.")
+		return nil
+	}
+
+	attachmentType, devicePath, err := getDevicePathAndAttachmentType(diskPath)
+	if err != nil {
+		logger.With(zap.Error(err)).With("diskPath", diskPath).Warn("Failed to determine the attachment type of the volume.")
+		return nil
+	}
+
+	var mountHandler disk.Interface
+	isMultipathEnabled := strings.HasPrefix(devicePath, "/dev/mapper")
+	switch {
+	case attachmentType == attachmentTypeISCSI && isMultipathEnabled:
+		if _, err := os.Stat(devicePath); os.IsNotExist(err) {
+			return abnormal("multipath device %s of the volume is missing", devicePath)
+		}
+		mountHandler = disk.NewISCSIUHPMounter(d.logger)
+	case attachmentType == attachmentTypeISCSI:
+		mountHandler = disk.NewFromISCSIDisk(d.logger, nil)
+	default:
+		mountHandler = disk.NewFromPVDisk(d.logger)
+	}
+
+	loggedIn, err := mountHandler.SessionLoggedIn(devicePath)
+	if err != nil {
+		logger.With(zap.Error(err)).With("devicePath", devicePath).Warn("Failed to get the iSCSI session state of the volume.")
+	} else if !loggedIn {
+		return abnormal("iSCSI session of the volume attachment of device %s is not logged in", devicePath)
+	}
+
+	if isMultipathEnabled {
+		pathStates, err := disk.MultipathPathStates(devicePath)
+		if err != nil {
+			logger.With(zap.Error(err)).With("devicePath", devicePath).Warn("Failed to get the path states of the multipath device of the volume.")
+			return nil
+		}
+		var failedPaths []string
+		for path, state := range pathStates {
+			if state != disk.SCSIDeviceStateRunning {
+				failedPaths = append(failedPaths, fmt.Sprintf("%s (%s)", path, state))
+			}
+		}
+		if len(pathStates) == 0 {
+			return abnormal("multipath device %s of the volume has no path", devicePath)
+		}
+		if len(failedPaths) > 0 {
+			sort.Strings(failedPaths)
+			return abnormal("%d of %d paths of multipath device %s of the volume are not running: %s",
+				len(failedPaths), len(pathStates), devicePath, strings.Join(failedPaths, ", "))
+		}
+	}
+	return nil
+}
+
+// nodeVolumeCondition returns the condition of the volume published at the
+// volume path, abnormal if it is unhealthy on the node. The message of a
+// healthy volume has its performance tier when it was staged, if recorded.
+func (d BlockVolumeNodeDriver) nodeVolumeCondition(logger *zap.SugaredLogger, volumePath, stagingTargetPath string, isRawBlockVolume bool, stagedState *stagedVolumeState) *csi.VolumeCondition {
+	readOnly := stagedState != nil && stagedState.ReadOnly
+	if condition := d.volumeHealthCondition(logger, volumePath, stagingTargetPath, isRawBlockVolume, readOnly); condition != nil {
+		return condition
+	}
+	return healthyVolumeCondition(stagedState)
+}
+
+// healthyVolumeCondition returns the condition of a healthy volume.
+func healthyVolumeCondition(stagedState *stagedVolumeState) *csi.VolumeCondition {
+	message := "volume is healthy"
+	if tier := stagedState.performanceTierMessage(); tier != "" {
+		message += ", " + tier
+	}
+	return &csi.VolumeCondition{Abnormal: false, Message: message}
+}
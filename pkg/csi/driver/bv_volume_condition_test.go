@@ -0,0 +1,354 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubeAPI "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
+)
+
+func TestVolumeCondition(t *testing.T) {
+	attachment := func(instanceID string, state core.VolumeAttachmentLifecycleStateEnum, loginState core.VolumeAttachmentIscsiLoginStateEnum) core.VolumeAttachment {
+		return core.IScsiVolumeAttachment{
+			InstanceId:      common.String(instanceID),
+			LifecycleState:  state,
+			IscsiLoginState: loginState,
+		}
+	}
+	nodeNames := map[string]string{"ocid1.instance.oc1.node1": "node1"}
+
+	tests := []struct {
+		name           string
+		lifecycleState string
+		attachments    []core.VolumeAttachment
+		want           *csi.VolumeCondition
+	}{
+		{
+			name:           "available volume",
+			lifecycleState: string(core.VolumeLifecycleStateAvailable),
+			want:           &csi.VolumeCondition{Abnormal: false, Message: "volume is AVAILABLE"},
+		},
+		{
+			name:           "attached volume",
+			lifecycleState: string(core.VolumeLifecycleStateAvailable),
+			attachments: []core.VolumeAttachment{
+				attachment("ocid1.instance.oc1.node1", core.VolumeAttachmentLifecycleStateAttached, core.VolumeAttachmentIscsiLoginStateLoginSucceeded),
+				attachment("ocid1.instance.oc1.other", core.VolumeAttachmentLifecycleStateDetaching, ""),
+			},
+			want: &csi.VolumeCondition{
+				Abnormal: false,
+				Message:  "volume is AVAILABLE, attachment to node node1 is ATTACHED, attachment to ocid1.instance.oc1.other is DETACHING",
+			},
+		},
+		{
+			name:           "faulty volume",
+			lifecycleState: string(core.VolumeLifecycleStateFaulty),
+			want:           &csi.VolumeCondition{Abnormal: true, Message: "volume is FAULTY"},
+		},
+		{
+			name:           "terminating volume",
+			lifecycleState: string(core.VolumeLifecycleStateTerminating),
+			want:           &csi.VolumeCondition{Abnormal: true, Message: "volume is TERMINATING"},
+		},
+		{
+			name:           "iSCSI login failed",
+			lifecycleState: string(core.VolumeLifecycleStateAvailable),
+			attachments: []core.VolumeAttachment{
+				attachment("ocid1.instance.oc1.node1", core.VolumeAttachmentLifecycleStateAttached, core.VolumeAttachmentIscsiLoginStateLoginFailed),
+			},
+			want: &csi.VolumeCondition{
+				Abnormal: true,
+				Message:  "volume is AVAILABLE, attachment to node node1 is ATTACHED with iSCSI login state LOGIN_FAILED",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := volumeCondition(tt.lifecycleState, tt.attachments, nodeNames); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("volumeCondition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMountedReadOnly(t *testing.T) {
+	mountsPath := filepath.Join(t.TempDir(), "mounts")
+	mounts := "/dev/sda1 / xfs rw,relatime 0 0\n" +
+		"/dev/sdb /var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/healthy/globalmount ext4 rw,relatime 0 0\n" +
+		"/dev/sdc /var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/failed/globalmount ext4 ro,relatime 0 0\n"
+	if err := os.WriteFile(mountsPath, []byte(mounts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    bool
+		wantErr error
+	}{
+		{
+			name: "mounted read-write",
+			path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/healthy/globalmount",
+		},
+		{
+			name: "remounted read-only",
+			path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/failed/globalmount",
+			want: true,
+		},
+		{
+			name:    "not mounted",
+			path:    "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/missing/globalmount",
+			wantErr: disk.ErrMountPointNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mountedReadOnly(mountsPath, tt.path)
+			if err != tt.wantErr {
+				t.Fatalf("mountedReadOnly() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mountedReadOnly() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStagingReadOnlyCondition(t *testing.T) {
+	mountsPath := filepath.Join(t.TempDir(), "mounts")
+	mounts := "/dev/sdb /var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/healthy/globalmount ext4 rw,relatime 0 0\n" +
+		"/dev/sdc /var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/readonly/globalmount ext4 ro,relatime 0 0\n"
+	if err := os.WriteFile(mountsPath, []byte(mounts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		readOnly     bool
+		wantAbnormal bool
+	}{
+		{
+			name: "mounted read-write",
+			path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/healthy/globalmount",
+		},
+		{
+			name:         "remounted read-only",
+			path:         "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/readonly/globalmount",
+			wantAbnormal: true,
+		},
+		{
+			name:     "MULTI_NODE_READER_ONLY volume mounted read-only",
+			path:     "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/readonly/globalmount",
+			readOnly: true,
+		},
+		{
+			name: "not mounted",
+			path: "/var/lib/kubelet/plugins/kubernetes.io/csi/blockvolume.csi.oraclecloud.com/missing/globalmount",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stagingReadOnlyCondition(zap.S(), mountsPath, tt.path, tt.readOnly)
+			if abnormal := got != nil && got.Abnormal; abnormal != tt.wantAbnormal {
+				t.Errorf("stagingReadOnlyCondition() = %v, want abnormal %t", got, tt.wantAbnormal)
+			}
+		})
+	}
+}
+
+func TestStagedVolumeState(t *testing.T) {
+	defer func(dir string) { kubeletPluginsDir = dir }(kubeletPluginsDir)
+	kubeletPluginsDir = t.TempDir()
+
+	volumeID := "ocid1.volume.oc1.phx.readonly"
+	if state, err := readStagedVolumeState(volumeID); err != nil || state != nil {
+		t.Fatalf("readStagedVolumeState() of a volume without state = %v, %v, want nil", state, err)
+	}
+	want := newStagedVolumeState(map[string]string{
+		attachmentType:        attachmentTypeISCSI,
+		"vpusPerGB":           "10",
+		autoTunedVpusPerGBKey: "20",
+	})
+	want.ReadOnly = true
+	if len(want.PerformanceTier) != 2 {
+		t.Errorf("newStagedVolumeState() performance tier = %v, want vpusPerGB and autoTunedVpusPerGB only", want.PerformanceTier)
+	}
+	if err := writeStagedVolumeState(volumeID, want); err != nil {
+		t.Fatalf("writeStagedVolumeState() failed: %v", err)
+	}
+	got, err := readStagedVolumeState(volumeID)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("readStagedVolumeState() = %+v, %v, want %+v", got, err, want)
+	}
+	if err := removeStagedVolumeState(volumeID); err != nil {
+		t.Fatalf("removeStagedVolumeState() failed: %v", err)
+	}
+	if state, err := readStagedVolumeState(volumeID); err != nil || state != nil {
+		t.Errorf("readStagedVolumeState() of a removed state = %v, %v, want nil", state, err)
+	}
+}
+
+func TestHealthyVolumeCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		stagedState *stagedVolumeState
+		want        string
+	}{
+		{
+			name: "volume staged by an older driver",
+			want: "volume is healthy",
+		},
+		{
+			name:        "volume published without performance tier",
+			stagedState: &stagedVolumeState{ReadOnly: true},
+			want:        "volume is healthy",
+		},
+		{
+			name: "volume without autotune",
+			stagedState: newStagedVolumeState(map[string]string{
+				"vpusPerGB": "20", autoTunedVpusPerGBKey: "20", detachedAutotuneKey: "false", maxVpusPerGBKey: "0",
+			}),
+			want: "volume is healthy, performance tier: 20 vpusPerGB",
+		},
+		{
+			name: "autotuned volume",
+			stagedState: newStagedVolumeState(map[string]string{
+				"vpusPerGB": "10", autoTunedVpusPerGBKey: "30", detachedAutotuneKey: "true", maxVpusPerGBKey: "40",
+			}),
+			want: "volume is healthy, performance tier: 10 vpusPerGB, autotuned to 30 vpusPerGB, detached volume autotune enabled, performance based autotune up to 40 vpusPerGB",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := healthyVolumeCondition(tt.stagedState)
+			if got.Abnormal || got.Message != tt.want {
+				t.Errorf("healthyVolumeCondition() = %+v, want normal with message %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestControllerDriver_ControllerGetVolume(t *testing.T) {
+	faultyVolumeID := "ocid1.volume.oc1.faulty"
+	volumes[faultyVolumeID] = &core.Volume{
+		Id:             common.String(faultyVolumeID),
+		LifecycleState: core.VolumeLifecycleStateFaulty,
+		SizeInMBs:      common.Int64(51200),
+	}
+	defer delete(volumes, faultyVolumeID)
+	autotunedVolumeID := "ocid1.volume.oc1.autotuned"
+	volumes[autotunedVolumeID] = &core.Volume{
+		Id:                 common.String(autotunedVolumeID),
+		LifecycleState:     core.VolumeLifecycleStateAvailable,
+		VpusPerGB:          common.Int64(10),
+		AutoTunedVpusPerGB: common.Int64(20),
+		AutotunePolicies: []core.AutotunePolicy{
+			core.DetachedVolumeAutotunePolicy{},
+			core.PerformanceBasedAutotunePolicy{MaxVpusPerGB: common.Int64(30)},
+		},
+	}
+	defer delete(volumes, autotunedVolumeID)
+
+	tests := []struct {
+		name     string
+		volumeID string
+		want     *csi.ControllerGetVolumeResponse
+		wantCode codes.Code
+	}{
+		{
+			name:     "missing volume id",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "volume not found",
+			volumeID: "ocid1.volume.oc1.missing",
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "attached volume",
+			volumeID: "ocid1.volume.oc1.attached",
+			want: &csi.ControllerGetVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: "ocid1.volume.oc1.attached",
+					VolumeContext: map[string]string{
+						"vpusPerGB": "10", autoTunedVpusPerGBKey: "10", detachedAutotuneKey: "false", maxVpusPerGBKey: "0",
+					},
+				},
+				Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+					PublishedNodeIds: []string{"node1"},
+					VolumeCondition:  &csi.VolumeCondition{Abnormal: false, Message: "volume is AVAILABLE, attachment to node node1 is ATTACHED"},
+				},
+			},
+		},
+		{
+			name:     "autotuned volume",
+			volumeID: autotunedVolumeID,
+			want: &csi.ControllerGetVolumeResponse{
+				Volume: &csi.Volume{
+					VolumeId: autotunedVolumeID,
+					VolumeContext: map[string]string{
+						"vpusPerGB": "10", autoTunedVpusPerGBKey: "20", detachedAutotuneKey: "true", maxVpusPerGBKey: "30",
+					},
+				},
+				Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+					VolumeCondition: &csi.VolumeCondition{Abnormal: false, Message: "volume is AVAILABLE"},
+				},
+			},
+		},
+		{
+			name:     "faulty volume",
+			volumeID: faultyVolumeID,
+			want: &csi.ControllerGetVolumeResponse{
+				Volume: &csi.Volume{VolumeId: faultyVolumeID, CapacityBytes: 50 * 1024 * 1024 * 1024},
+				Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+					VolumeCondition: &csi.VolumeCondition{Abnormal: true, Message: "volume is FAULTY"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newGroupControllerDriver()
+			d.KubeClient = fake.NewSimpleClientset(&kubeAPI.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+				Spec:       kubeAPI.NodeSpec{ProviderID: "oci://ocid1.instance.oc1.node1"},
+			})
+
+			got, err := d.ControllerGetVolume(context.Background(), &csi.ControllerGetVolumeRequest{VolumeId: tt.volumeID})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ControllerGetVolume() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ControllerGetVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
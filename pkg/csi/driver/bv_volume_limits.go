@@ -0,0 +1,196 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// sysBlockPath is the sysfs directory of the block devices of the node
+	sysBlockPath = "/sys/block"
+)
+
+// volumeAttachmentLimit is the maximum number of block volumes, including the
+// boot volume, attached to an instance of a shape by attachment type, 0 if the
+// attachment type is not supported by the shape.
+type volumeAttachmentLimit struct {
+	iscsi           int64
+	paravirtualized int64
+}
+
+// shapeVolumeAttachmentLimits are the volume attachment limits of the shapes
+// by shape name or prefix of the shape name up to a ".", the longest matching
+// prefix applies. Shapes not listed, e.g. new shapes, are unknown. See
+// https://docs.oracle.com/en-us/iaas/Content/Block/Concepts/overview.htm#limits
+var shapeVolumeAttachmentLimits = map[string]volumeAttachmentLimit{
+	// Virtual machines with a single OCPU support fewer attachments
+	"VM.Standard.E2.1.Micro": {iscsi: 8, paravirtualized: 8},
+	"VM.Standard1.1":         {iscsi: 16, paravirtualized: 16},
+	"VM.Standard2.1":         {iscsi: 16, paravirtualized: 16},
+	"VM.Standard.E2.1":       {iscsi: 16, paravirtualized: 16},
+	"VM.DenseIO1.4":          {iscsi: 16, paravirtualized: 16},
+
+	"VM.Standard1":   {iscsi: 32, paravirtualized: 32},
+	"VM.Standard2":   {iscsi: 32, paravirtualized: 32},
+	"VM.Standard3":   {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.B1": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.E2": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.E3": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.E4": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.E5": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.E6": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.A1": {iscsi: 32, paravirtualized: 32},
+	"VM.Standard.A2": {iscsi: 32, paravirtualized: 32},
+	"VM.DenseIO1":    {iscsi: 32, paravirtualized: 32},
+	"VM.DenseIO2":    {iscsi: 32, paravirtualized: 32},
+	"VM.DenseIO.E4":  {iscsi: 32, paravirtualized: 32},
+	"VM.DenseIO.E5":  {iscsi: 32, paravirtualized: 32},
+	"VM.Optimized3":  {iscsi: 32, paravirtualized: 32},
+	"VM.GPU2":        {iscsi: 32, paravirtualized: 32},
+	"VM.GPU3":        {iscsi: 32, paravirtualized: 32},
+	"VM.GPU.A10":     {iscsi: 32, paravirtualized: 32},
+
+	// Bare metal instances only support iSCSI attachments
+	"BM.Standard1":   {iscsi: 32},
+	"BM.Standard2":   {iscsi: 32},
+	"BM.Standard.B1": {iscsi: 32},
+	"BM.Standard.E2": {iscsi: 32},
+	"BM.DenseIO1":    {iscsi: 32},
+	"BM.DenseIO2":    {iscsi: 32},
+	"BM.HPC2":        {iscsi: 32},
+	"BM.GPU2":        {iscsi: 32},
+	"BM.GPU3":        {iscsi: 32},
+	"BM.Standard3":   {iscsi: 64},
+	"BM.Standard.E3": {iscsi: 64},
+	"BM.Standard.E4": {iscsi: 64},
+	"BM.Standard.E5": {iscsi: 64},
+	"BM.Standard.E6": {iscsi: 64},
+	"BM.Standard.A1": {iscsi: 64},
+	"BM.DenseIO.E4":  {iscsi: 64},
+	"BM.DenseIO.E5":  {iscsi: 64},
+	"BM.Optimized3":  {iscsi: 64},
+	"BM.GPU4":        {iscsi: 64},
+	"BM.GPU.A10":     {iscsi: 64},
+	"BM.GPU.A100-v2": {iscsi: 64},
+	"BM.GPU.H100":    {iscsi: 64},
+}
+
+// shapeMaxVolumeAttachments returns the maximum number of block volumes,
+// including the boot volume, attached to instances of the shape with any of
+// the attachment types supported by the shape, false if the shape is unknown.
+func shapeMaxVolumeAttachments(shape string) (int64, bool) {
+	prefix := ""
+	for p := range shapeVolumeAttachmentLimits {
+		if (shape == p || strings.HasPrefix(shape, p+".")) && len(p) > len(prefix) {
+			prefix = p
+		}
+	}
+	if prefix == "" {
+		return 0, false
+	}
+
+	limit := shapeVolumeAttachmentLimits[prefix]
+	maxAttachments := limit.iscsi
+	if limit.paravirtualized != 0 && (maxAttachments == 0 || limit.paravirtualized < maxAttachments) {
+		maxAttachments = limit.paravirtualized
+	}
+	return maxAttachments, maxAttachments != 0
+}
+
+// nodeMaxVolumesPerNode returns the number of block volumes the CSI driver can
+// attach to the node: the attachment limit of the shape of the node, or the
+// configured or default limit for unknown shapes, minus the volumes attached
+// to the node not by the CSI driver, such as the boot volume.
+func (d BlockVolumeNodeDriver) nodeMaxVolumesPerNode(ctx context.Context, sysBlockPath string) int64 {
+	log := d.logger.With("nodeId", d.nodeID, "shape", d.nodeMetadata.Shape)
+
+	maxVolumes, ok := shapeMaxVolumeAttachments(d.nodeMetadata.Shape)
+	if !ok {
+		maxVolumes = defaultMaxVolumesPerNode
+		if d.maxVolumesPerNode > 0 {
+			maxVolumes = d.maxVolumesPerNode
+		}
+		log.With("maxVolumesPerNode", maxVolumes).Warn("Volume attachment limit of the shape of the node is unknown, using the configured limit.")
+	}
+
+	// The boot volume is the only volume not attached by the CSI driver unless
+	// the attached volumes can be counted
+	otherVolumes := int64(1)
+	if attachedVolumes, err := attachedBlockVolumeCount(sysBlockPath); err != nil {
+		log.With(zap.Error(err)).Warn("Failed to count the block volumes attached to the node.")
+	} else if csiVolumes, err := d.csiAttachedVolumeCount(ctx); err != nil {
+		log.With(zap.Error(err)).Warn("Failed to count the volume attachments of the node.")
+	} else if attachedVolumes > csiVolumes {
+		otherVolumes = attachedVolumes - csiVolumes
+	}
+
+	maxVolumes -= otherVolumes
+	if maxVolumes < 1 {
+		// 0 would mean that the number of volumes is not limited
+		log.With("otherVolumes", otherVolumes).Warn("No volume can be attached to the node by the CSI driver.")
+		maxVolumes = 1
+	}
+	log.With("maxVolumesPerNode", maxVolumes, "otherVolumes", otherVolumes).Info("Volume attachment limit of the node identified.")
+	return maxVolumes
+}
+
+// attachedBlockVolumeCount returns the number of block volumes attached to the
+// node, which are SCSI disks, with paravirtualized or iSCSI attachments. The
+// disks of the paths of multipath attachments share the identifier of their
+// volume.
+func attachedBlockVolumeCount(sysBlockPath string) (int64, error) {
+	entries, err := os.ReadDir(sysBlockPath)
+	if err != nil {
+		return 0, err
+	}
+
+	volumes := make(map[string]struct{})
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "sd") {
+			continue
+		}
+		id := entry.Name()
+		if wwid, err := os.ReadFile(filepath.Join(sysBlockPath, entry.Name(), "device", "wwid")); err == nil && len(strings.TrimSpace(string(wwid))) > 0 {
+			id = strings.TrimSpace(string(wwid))
+		}
+		volumes[id] = struct{}{}
+	}
+	return int64(len(volumes)), nil
+}
+
+// csiAttachedVolumeCount returns the number of volumes attached to the node by
+// the block volume CSI driver.
+func (d BlockVolumeNodeDriver) csiAttachedVolumeCount(ctx context.Context) (int64, error) {
+	volumeAttachments, err := d.KubeClient.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, volumeAttachment := range volumeAttachments.Items {
+		if volumeAttachment.Spec.Attacher == BlockVolumeDriverName && volumeAttachment.Spec.NodeName == d.nodeID &&
+			volumeAttachment.Status.Attached {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	csi_util "github.com/oracle/oci-cloud-controller-manager/pkg/csi-util"
+)
+
+// setupSysBlock creates a sysfs block directory with the disks and their
+// identifiers, no identifier for an empty one.
+func setupSysBlock(t *testing.T, disks map[string]string) string {
+	sysBlock := t.TempDir()
+	for disk, wwid := range disks {
+		device := filepath.Join(sysBlock, disk, "device")
+		if err := os.MkdirAll(device, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if wwid == "" {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(device, "wwid"), []byte(wwid+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return sysBlock
+}
+
+func TestShapeMaxVolumeAttachments(t *testing.T) {
+	tests := []struct {
+		shape  string
+		want   int64
+		wantOk bool
+	}{
+		{shape: "VM.Standard.E2.1.Micro", want: 8, wantOk: true},
+		{shape: "VM.Standard2.1", want: 16, wantOk: true},
+		{shape: "VM.Standard2.16", want: 32, wantOk: true},
+		{shape: "VM.Standard.E4.Flex", want: 32, wantOk: true},
+		{shape: "BM.Standard2.52", want: 32, wantOk: true},
+		{shape: "BM.Standard3.64", want: 64, wantOk: true},
+		{shape: "VM.Standard.X9.Flex", wantOk: false},
+		{shape: "BM.Standard", wantOk: false},
+		{shape: "Custom.Shape", wantOk: false},
+		{shape: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.shape, func(t *testing.T) {
+			got, ok := shapeMaxVolumeAttachments(tt.shape)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("shapeMaxVolumeAttachments() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestAttachedBlockVolumeCount(t *testing.T) {
+	sysBlock := setupSysBlock(t, map[string]string{
+		"sda":     "naa.boot",
+		"sdb":     "naa.volume1",
+		"sdc":     "naa.volume2",
+		"sdd":     "naa.volume2", // second path of a multipath attachment
+		"sde":     "",
+		"nvme0n1": "nvme.local",
+		"loop0":   "",
+	})
+	got, err := attachedBlockVolumeCount(sysBlock)
+	if err != nil {
+		t.Fatalf("attachedBlockVolumeCount() error = %v", err)
+	}
+	if got != 4 {
+		t.Errorf("attachedBlockVolumeCount() = %d, want 4", got)
+	}
+
+	if _, err := attachedBlockVolumeCount(filepath.Join(sysBlock, "missing")); err == nil {
+		t.Errorf("attachedBlockVolumeCount() of a missing directory succeeded")
+	}
+}
+
+func TestBlockVolumeNodeDriver_nodeMaxVolumesPerNode(t *testing.T) {
+	volumeAttachment := func(name, attacher, node string, attached bool) *storagev1.VolumeAttachment {
+		return &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       storagev1.VolumeAttachmentSpec{Attacher: attacher, NodeName: node},
+			Status:     storagev1.VolumeAttachmentStatus{Attached: attached},
+		}
+	}
+	kubeClient := fake.NewSimpleClientset(
+		volumeAttachment("csi-1", BlockVolumeDriverName, "node1", true),
+		volumeAttachment("csi-2", BlockVolumeDriverName, "node1", false),
+		volumeAttachment("csi-3", BlockVolumeDriverName, "node2", true),
+		volumeAttachment("fss-1", FSSDriverName, "node1", true),
+	)
+	sysBlock := setupSysBlock(t, map[string]string{"sda": "naa.boot", "sdb": "naa.csi", "sdc": "naa.manual"})
+
+	tests := []struct {
+		name              string
+		shape             string
+		maxVolumesPerNode int64
+		sysBlock          string
+		want              int64
+	}{
+		{
+			name:     "known shape with volumes attached outside of kubernetes",
+			shape:    "VM.Standard.E4.Flex",
+			sysBlock: sysBlock,
+			want:     30,
+		},
+		{
+			name:     "unknown shape",
+			shape:    "Custom.Shape",
+			sysBlock: sysBlock,
+			want:     30,
+		},
+		{
+			name:              "unknown shape with configured limit",
+			shape:             "Custom.Shape",
+			maxVolumesPerNode: 16,
+			sysBlock:          sysBlock,
+			want:              14,
+		},
+		{
+			name:              "new shape with configured limit",
+			shape:             "VM.Standard.X9.Flex",
+			maxVolumesPerNode: 16,
+			sysBlock:          sysBlock,
+			want:              14,
+		},
+		{
+			name:              "shape not loaded with configured limit",
+			maxVolumesPerNode: 16,
+			sysBlock:          sysBlock,
+			want:              14,
+		},
+		{
+			name:     "small virtual machine",
+			shape:    "VM.Standard2.1",
+			sysBlock: sysBlock,
+			want:     14,
+		},
+		{
+			name:     "bare metal",
+			shape:    "BM.Standard.E4.128",
+			sysBlock: sysBlock,
+			want:     62,
+		},
+		{
+			name:              "configured limit of known shape ignored",
+			shape:             "VM.Standard.E4.Flex",
+			maxVolumesPerNode: 16,
+			sysBlock:          sysBlock,
+			want:              30,
+		},
+		{
+			name:     "attached volumes cannot be counted",
+			shape:    "VM.Standard.E4.Flex",
+			sysBlock: filepath.Join(sysBlock, "missing"),
+			want:     31,
+		},
+		{
+			name:              "no volume attachable",
+			shape:             "Custom.Shape",
+			maxVolumesPerNode: 2,
+			sysBlock:          sysBlock,
+			want:              1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := BlockVolumeNodeDriver{
+				NodeDriver: NodeDriver{
+					nodeID:       "node1",
+					KubeClient:   kubeClient,
+					logger:       zap.S(),
+					nodeMetadata: &csi_util.NodeMetadata{Shape: tt.shape},
+				},
+				maxVolumesPerNode: tt.maxVolumesPerNode,
+			}
+			if got := d.nodeMaxVolumesPerNode(context.Background(), tt.sysBlock); got != tt.want {
+				t.Errorf("nodeMaxVolumesPerNode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubeAPI "k8s.io/api/core/v1"
+)
+
+// capacityEnabled returns true if storage capacity tracking is enabled.
+func (d *ControllerDriver) capacityEnabled() bool {
+	return d.config != nil && d.config.Capacity != nil
+}
+
+// topologyAvailabilityDomain returns the short name of the availability
+// domain of the topology, empty if it has none.
+func topologyAvailabilityDomain(topology *csi.Topology) string {
+	if ad, ok := topology.GetSegments()[kubeAPI.LabelTopologyZone]; ok {
+		return ad
+	}
+	return topology.GetSegments()[kubeAPI.LabelZoneFailureDomain]
+}
+
+// availableCapacity returns the amount of the service limit available to the
+// compartment in the availability domain, or in all availability domains if
+// availabilityDomain is empty, minus the configured headroom.
+func (d *ControllerDriver) availableCapacity(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (int64, error) {
+	log := d.logger.With("serviceName", serviceName, "limitName", limitName, "compartmentID", compartmentID,
+		"availabilityDomain", availabilityDomain)
+
+	var availabilityDomains []string
+	if availabilityDomain != "" {
+		ad, err := d.client.Identity(nil).GetAvailabilityDomainByName(ctx, compartmentID, availabilityDomain)
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to get the availability domain.")
+			return 0, status.Errorf(codes.InvalidArgument, "invalid availability domain %s: %v", availabilityDomain, err)
+		}
+		availabilityDomains = append(availabilityDomains, *ad.Name)
+	} else {
+		ads, err := d.client.Identity(nil).ListAvailabilityDomains(ctx, compartmentID)
+		if err != nil {
+			log.With(zap.Error(err)).Error("Failed to list the availability domains.")
+			return 0, status.Errorf(codes.Internal, "failed to list the availability domains: %v", err)
+		}
+		for _, ad := range ads {
+			availabilityDomains = append(availabilityDomains, *ad.Name)
+		}
+	}
+
+	var total int64
+	for _, ad := range availabilityDomains {
+		availability, err := d.client.Limits().GetResourceAvailability(ctx, serviceName, limitName, compartmentID, ad)
+		if err != nil {
+			log.With(zap.Error(err)).Errorf("Failed to get the resource availability in %s.", ad)
+			return 0, status.Errorf(codes.Internal, "failed to get the availability of %s in %s: %v", limitName, ad, err)
+		}
+		var used, available int64
+		if availability.Used != nil {
+			used = *availability.Used
+		}
+		if availability.Available != nil {
+			available = *availability.Available
+		}
+		// the headroom is a share of the limit, or of the quota if lower
+		available -= (used + available) * int64(d.config.Capacity.HeadroomPercent) / 100
+		if available > 0 {
+			total += available
+		}
+	}
+	log.With("available", total).Debug("Computed the available capacity.")
+	return total, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kubeAPI "k8s.io/api/core/v1"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+// fakeLimitsClient serves the resource availabilities by service, limit and
+// availability domain.
+type fakeLimitsClient struct {
+	availabilities map[string]*limits.ResourceAvailability
+}
+
+func (c *fakeLimitsClient) GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error) {
+	availability, ok := c.availabilities[serviceName+"/"+limitName+"/"+availabilityDomain]
+	if !ok {
+		return nil, fmt.Errorf("limit %s of %s not found in %s", limitName, serviceName, availabilityDomain)
+	}
+	return availability, nil
+}
+
+func TestBlockVolumeControllerDriver_GetCapacity(t *testing.T) {
+	limitsClient := &fakeLimitsClient{availabilities: map[string]*limits.ResourceAvailability{
+		"block-storage/total-storage-gb/AD1": {Used: common.Int64(800), Available: common.Int64(200)},
+	}}
+	topology := &csi.Topology{Segments: map[string]string{kubeAPI.LabelTopologyZone: "PHX-AD-1"}}
+	tests := []struct {
+		name     string
+		capacity *providercfg.CapacityConfig
+		limits   client.LimitsInterface
+		want     int64
+		wantCode codes.Code
+	}{
+		{
+			name:     "disabled",
+			wantCode: codes.Unimplemented,
+		},
+		{
+			name:     "without headroom",
+			capacity: &providercfg.CapacityConfig{},
+			limits:   limitsClient,
+			want:     200 * client.GiB,
+		},
+		{
+			name:     "with headroom",
+			capacity: &providercfg.CapacityConfig{HeadroomPercent: 10},
+			limits:   limitsClient,
+			want:     100 * client.GiB,
+		},
+		{
+			name:     "headroom exceeds the available storage",
+			capacity: &providercfg.CapacityConfig{HeadroomPercent: 50},
+			limits:   limitsClient,
+			want:     0,
+		},
+		{
+			name:     "limits error",
+			capacity: &providercfg.CapacityConfig{},
+			limits:   &fakeLimitsClient{},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &BlockVolumeControllerDriver{ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "compartment", Capacity: tt.capacity},
+				client: &MockProvisionerClient{Storage: &MockBlockStorageClient{}, LimitsClient: tt.limits},
+			}}
+			got, err := d.GetCapacity(context.Background(), &csi.GetCapacityRequest{AccessibleTopology: topology})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetCapacity() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && got.AvailableCapacity != tt.want {
+				t.Errorf("GetCapacity() = %d, want %d", got.AvailableCapacity, tt.want)
+			}
+		})
+	}
+}
+
+func TestFSSControllerDriver_GetCapacity(t *testing.T) {
+	tests := []struct {
+		name         string
+		availability *limits.ResourceAvailability
+		want         int64
+	}{
+		{
+			name:         "file systems available",
+			availability: &limits.ResourceAvailability{Used: common.Int64(10), Available: common.Int64(90)},
+			want:         fssMaximumFileSystemSize,
+		},
+		{
+			name:         "file system count exhausted",
+			availability: &limits.ResourceAvailability{Used: common.Int64(100), Available: common.Int64(0)},
+			want:         0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &FSSControllerDriver{ControllerDriver: ControllerDriver{
+				logger: zap.S(),
+				config: &providercfg.Config{CompartmentID: "compartment", Capacity: &providercfg.CapacityConfig{}},
+				client: &MockFSSProvisionerClient{LimitsClient: &fakeLimitsClient{availabilities: map[string]*limits.ResourceAvailability{
+					"filesystem/file-system-count/AD1": tt.availability,
+				}}},
+			}}
+			got, err := d.GetCapacity(context.Background(), &csi.GetCapacityRequest{
+				Parameters: map[string]string{"availabilityDomain": "PHX-AD-1"},
+			})
+			if err != nil {
+				t.Fatalf("GetCapacity() error = %v", err)
+			}
+			if got.AvailableCapacity != tt.want {
+				t.Errorf("GetCapacity() = %d, want %d", got.AvailableCapacity, tt.want)
+			}
+		})
+	}
+}
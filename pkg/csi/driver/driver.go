@@ -27,6 +27,7 @@ import (
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/kubernetes-csi/csi-lib-utils/protosanitizer"
+	snapshotclientset "github.com/kubernetes-csi/external-snapshotter/client/v6/clientset/versioned"
 
 	"github.com/oracle/oci-cloud-controller-manager/cmd/oci-csi-node-driver/nodedriveroptions"
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
@@ -34,8 +35,11 @@ import (
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/oci/instance/metadata"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
@@ -106,6 +110,7 @@ type Driver struct {
 // ControllerDriver implements CSI Controller interfaces
 type ControllerDriver struct {
 	KubeClient      kubernetes.Interface
+	SnapshotClient  snapshotclientset.Interface
 	logger          *zap.SugaredLogger
 	config          *providercfg.Config
 	client          client.Interface
@@ -113,6 +118,7 @@ type ControllerDriver struct {
 	metricPusher    *metrics.MetricPusher
 	clusterIpFamily string
 	csi.UnimplementedControllerServer
+	csi.UnimplementedGroupControllerServer
 }
 
 // BlockVolumeControllerDriver extends ControllerDriver
@@ -141,6 +147,11 @@ type NodeDriver struct {
 // BlockVolumeNodeDriver extends NodeDriver
 type BlockVolumeNodeDriver struct {
 	NodeDriver
+	// maxVolumesPerNode is the attachment limit of nodes of unknown shapes, 0 for the default
+	maxVolumesPerNode int64
+	// ephemeralClient creates and deletes the block volumes of ephemeral
+	// volumes, nil if ephemeral volumes are disabled
+	ephemeralClient client.Interface
 }
 
 // FSSNodeDriver extends NodeDriver
@@ -160,13 +171,31 @@ type ControllerDriverConfig struct {
 	DriverName             string
 	DriverVersion          string
 	ClusterIpFamily        string
+	// ForceDetachReconcilePeriod is the period of the force detach of the
+	// block volumes stuck on deleted nodes or terminated instances, 0 to
+	// disable it
+	ForceDetachReconcilePeriod time.Duration
+	// ForceDetachGracePeriod is how long the volume attachments of deleted
+	// nodes or terminated instances are deleted before their volumes are
+	// force detached
+	ForceDetachGracePeriod time.Duration
+	// EphemeralVolumeCleanupPeriod is the period of the clean up of the
+	// ephemeral volumes of terminated instances, 0 to disable it
+	EphemeralVolumeCleanupPeriod time.Duration
+	// VolumeReplicaSyncPeriod is the period of the updates of the block
+	// volume replicas annotations, 0 to disable them
+	VolumeReplicaSyncPeriod time.Duration
+	// StopCh stops the background loops of the driver when closed, they
+	// run until the process exits if nil
+	StopCh <-chan struct{}
 }
 
 type MetricPusherGetter func(logger *zap.SugaredLogger) (*metrics.MetricPusher, error)
 
-func newControllerDriver(kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger, config *providercfg.Config, c client.Interface, metricPusher *metrics.MetricPusher, clusterIpFamily string) ControllerDriver {
+func newControllerDriver(kubeClientSet kubernetes.Interface, snapshotClientSet snapshotclientset.Interface, logger *zap.SugaredLogger, config *providercfg.Config, c client.Interface, metricPusher *metrics.MetricPusher, clusterIpFamily string) ControllerDriver {
 	return ControllerDriver{
 		KubeClient:      kubeClientSet,
+		SnapshotClient:  snapshotClientSet,
 		logger:          logger,
 		util:            &csi_util.Util{Logger: logger},
 		config:          config,
@@ -188,7 +217,7 @@ func newNodeDriver(nodeID string, nodeMetaData *csi_util.NodeMetadata, kubeClien
 	}
 }
 
-func GetControllerDriver(name string, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger, config *providercfg.Config, c client.Interface, clusterIpFamily string) csi.ControllerServer {
+func GetControllerDriver(name string, kubeClientSet kubernetes.Interface, snapshotClientSet snapshotclientset.Interface, logger *zap.SugaredLogger, config *providercfg.Config, c client.Interface, clusterIpFamily string) csi.ControllerServer {
 	metricPusher, err := getMetricPusher(newMetricPusher, logger)
 	if err != nil {
 		logger.With("error", err).Error("Metrics collection could not be enabled")
@@ -197,7 +226,7 @@ func GetControllerDriver(name string, kubeClientSet kubernetes.Interface, logger
 	}
 
 	if name == BlockVolumeDriverName {
-		return &BlockVolumeControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, logger, config, c, metricPusher, clusterIpFamily)}
+		return &BlockVolumeControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, snapshotClientSet, logger, config, c, metricPusher, clusterIpFamily)}
 	}
 	if name == FSSDriverName {
 
@@ -209,7 +238,7 @@ func GetControllerDriver(name string, kubeClientSet kubernetes.Interface, logger
 			utilruntime.HandleError(fmt.Errorf("timed out waiting for informers to sync"))
 		}
 
-		return &FSSControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, logger, config, c, metricPusher, clusterIpFamily), serviceAccountLister: serviceAccountInformer.Lister()}
+		return &FSSControllerDriver{ControllerDriver: newControllerDriver(kubeClientSet, snapshotClientSet, logger, config, c, metricPusher, clusterIpFamily), serviceAccountLister: serviceAccountInformer.Lister()}
 
 	}
 	return nil
@@ -234,9 +263,9 @@ func getMetricPusher(metricPusherGetter MetricPusherGetter, logger *zap.SugaredL
 	return metricPusher, nil
 }
 
-func GetNodeDriver(name string, nodeID string, nodeMetadata *csi_util.NodeMetadata, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger, csiConfig *csi_util.CSIConfig) csi.NodeServer {
+func GetNodeDriver(name string, nodeID string, nodeMetadata *csi_util.NodeMetadata, kubeClientSet kubernetes.Interface, logger *zap.SugaredLogger, csiConfig *csi_util.CSIConfig, maxVolumesPerNode int64) csi.NodeServer {
 	if name == BlockVolumeDriverName {
-		return BlockVolumeNodeDriver{NodeDriver: newNodeDriver(nodeID, nodeMetadata, kubeClientSet, logger, csiConfig)}
+		return BlockVolumeNodeDriver{NodeDriver: newNodeDriver(nodeID, nodeMetadata, kubeClientSet, logger, csiConfig), maxVolumesPerNode: maxVolumesPerNode}
 	}
 	if name == FSSDriverName {
 		return FSSNodeDriver{NodeDriver: newNodeDriver(nodeID, nodeMetadata, kubeClientSet, logger, csiConfig)}
@@ -256,9 +285,21 @@ func NewNodeDriver(logger *zap.SugaredLogger, nodeOptions nodedriveroptions.Node
 	nodeMetadata := &csi_util.NodeMetadata{}
 	csiConfig := &csi_util.CSIConfig{}
 
+	nodeDriver := GetNodeDriver(nodeOptions.DriverName, nodeOptions.NodeID, nodeMetadata, kubeClientSet, logger, csiConfig, nodeOptions.MaxVolumesPerNode)
+	if bvNodeDriver, ok := nodeDriver.(BlockVolumeNodeDriver); ok && nodeOptions.EphemeralVolumeConfig != "" {
+		bvNodeDriver.ephemeralClient = getEphemeralClient(logger, nodeOptions.EphemeralVolumeConfig)
+		nodeDriver = bvNodeDriver
+	}
+	if bvNodeDriver, ok := nodeDriver.(BlockVolumeNodeDriver); ok && nodeOptions.StaleAttachmentReconcilePeriod > 0 {
+		go bvNodeDriver.runStaleAttachmentReconciler(nodeOptions.StaleAttachmentReconcilePeriod, nodeOptions.StaleAttachmentReconcileDryRun, wait.NeverStop)
+	}
+	if bvNodeDriver, ok := nodeDriver.(BlockVolumeNodeDriver); ok && nodeOptions.AnnotateAttachmentSupport {
+		go bvNodeDriver.annotateNodeAttachmentSupport(wait.NeverStop)
+	}
+
 	return &Driver{
 		controllerDriver:       nil,
-		nodeDriver:             GetNodeDriver(nodeOptions.DriverName, nodeOptions.NodeID, nodeMetadata, kubeClientSet, logger, csiConfig),
+		nodeDriver:             nodeDriver,
 		endpoint:               nodeOptions.Endpoint,
 		logger:                 logger,
 		enableControllerServer: nodeOptions.EnableControllerServer,
@@ -274,13 +315,30 @@ func NewControllerDriver(logger *zap.SugaredLogger, driverConfig ControllerDrive
 		driverConfig.CsiMaster).Info("Creating a new CSI Controller driver.")
 
 	kubeClientSet := csi_util.GetKubeClient(logger, driverConfig.CsiMaster, driverConfig.CsiKubeConfig)
+	snapshotClientSet := csi_util.GetSnapshotClient(logger, driverConfig.CsiMaster, driverConfig.CsiKubeConfig)
 
 	cfg := getConfig(logger)
 
 	c := getClient(logger)
 
+	stopCh := driverConfig.StopCh
+	if stopCh == nil {
+		stopCh = wait.NeverStop
+	}
+
+	controllerDriver := GetControllerDriver(driverConfig.DriverName, kubeClientSet, snapshotClientSet, logger, cfg, c, driverConfig.ClusterIpFamily)
+	if bvControllerDriver, ok := controllerDriver.(*BlockVolumeControllerDriver); ok && driverConfig.ForceDetachReconcilePeriod > 0 {
+		go bvControllerDriver.runForceDetachReconciler(driverConfig.ForceDetachReconcilePeriod, driverConfig.ForceDetachGracePeriod, stopCh)
+	}
+	if bvControllerDriver, ok := controllerDriver.(*BlockVolumeControllerDriver); ok && driverConfig.EphemeralVolumeCleanupPeriod > 0 {
+		go bvControllerDriver.runEphemeralVolumeCleanup(driverConfig.EphemeralVolumeCleanupPeriod, stopCh)
+	}
+	if bvControllerDriver, ok := controllerDriver.(*BlockVolumeControllerDriver); ok && driverConfig.VolumeReplicaSyncPeriod > 0 {
+		go bvControllerDriver.runVolumeReplicaAnnotationSync(driverConfig.VolumeReplicaSyncPeriod, stopCh)
+	}
+
 	return &Driver{
-		controllerDriver:       GetControllerDriver(driverConfig.DriverName, kubeClientSet, logger, cfg, c, driverConfig.ClusterIpFamily),
+		controllerDriver:       controllerDriver,
 		nodeDriver:             nil,
 		endpoint:               driverConfig.CsiEndpoint,
 		logger:                 logger,
@@ -349,19 +407,22 @@ func (d *Driver) Run() error {
 	errHandler := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		resp, err := handler(ctx, req)
 		if err != nil {
-			d.logger.With(zap.Error(err)).With("method", info.FullMethod, "request", protosanitizer.StripSecrets(req)).Error("Failed to process gRPC request.")
+			d.logger.With(zap.Error(err)).With("method", info.FullMethod, "request", protosanitizer.StripSecrets(stripPublishContextSecrets(req))).Error("Failed to process gRPC request.")
 		} else {
-			d.logger.With("method", info.FullMethod, "response", protosanitizer.StripSecrets(resp)).Info("gRPC response is sent successfully.")
+			d.logger.With("method", info.FullMethod, "response", protosanitizer.StripSecrets(stripPublishContextSecrets(resp))).Info("gRPC response is sent successfully.")
 		}
 
 		return resp, err
 	}
 
 	d.ready = true
-	d.srv = grpc.NewServer(grpc.UnaryInterceptor(errHandler))
+	d.srv = grpc.NewServer(grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(), errHandler))
 	csi.RegisterIdentityServer(d.srv, d)
 	if d.enableControllerServer {
 		csi.RegisterControllerServer(d.srv, d.GetControllerDriver())
+		if d.name == BlockVolumeDriverName {
+			csi.RegisterGroupControllerServer(d.srv, d.controllerDriver.(*BlockVolumeControllerDriver))
+		}
 	} else {
 		csi.RegisterNodeServer(d.srv, d.GetNodeDriver())
 	}
@@ -408,8 +469,50 @@ func getClient(logger *zap.SugaredLogger) client.Interface {
 	return c
 }
 
+// getEphemeralClient returns the client of the ephemeral volumes of the node
+// driver with the cloud-provider configuration at configPath.
+func getEphemeralClient(logger *zap.SugaredLogger, configPath string) client.Interface {
+	cfg, err := providercfg.FromFile(configPath)
+	if err != nil {
+		logger.With(zap.Error(err)).With("config", configPath).Fatal("Failed to load the configuration of ephemeral volumes.")
+	}
+	if err := cfg.Validate(); err != nil {
+		logger.With(zap.Error(err)).With("config", configPath).Fatal("Failed to validate. Invalid configuration of ephemeral volumes.")
+	}
+	c, err := client.GetClient(logger, cfg)
+	if err != nil {
+		logger.With(zap.Error(err)).Fatal("client of ephemeral volumes can not be generated.")
+	}
+	return c
+}
+
 // Stop stops the plugin
 func (d *Driver) Stop() {
 	d.logger.Info("Stopping the gRPC server")
 	d.srv.Stop()
 }
+
+// strippedSecret replaces secrets in logged messages, as in protosanitizer.
+const strippedSecret = "***stripped***"
+
+// publishContextMessage is a CSI message with a publish context.
+type publishContextMessage interface {
+	proto.Message
+	GetPublishContext() map[string]string
+}
+
+// stripPublishContextSecrets returns a copy of the message with the iSCSI CHAP
+// secret of its publish context masked. Publish contexts are not CSI secrets,
+// so protosanitizer does not strip them.
+func stripPublishContextSecrets(msg interface{}) interface{} {
+	m, ok := msg.(publishContextMessage)
+	if !ok {
+		return msg
+	}
+	if _, ok := m.GetPublishContext()[disk.ISCSICHAPSECRET]; !ok {
+		return msg
+	}
+	stripped := proto.Clone(m).(publishContextMessage)
+	stripped.GetPublishContext()[disk.ISCSICHAPSECRET] = strippedSecret
+	return stripped
+}
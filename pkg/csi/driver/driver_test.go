@@ -16,8 +16,10 @@ package driver
 
 import (
 	"fmt"
+	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/logging"
 	"github.com/oracle/oci-cloud-controller-manager/pkg/metrics"
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util/disk"
 	"go.uber.org/zap"
 	"testing"
 )
@@ -70,3 +72,26 @@ func getMetricPusherSuccess(logger *zap.SugaredLogger) (*metrics.MetricPusher, e
 func getMetricPusherFailure(logger *zap.SugaredLogger) (*metrics.MetricPusher, error) {
 	return nil, fmt.Errorf("failed to get metric pusher")
 }
+
+func Test_stripPublishContextSecrets(t *testing.T) {
+	req := &csi.NodeStageVolumeRequest{
+		VolumeId:       "ocid1.volume.oc1.chap",
+		PublishContext: map[string]string{disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:chap", disk.ISCSICHAPSECRET: "secret"},
+	}
+
+	stripped := stripPublishContextSecrets(req).(*csi.NodeStageVolumeRequest)
+	if got := stripped.PublishContext[disk.ISCSICHAPSECRET]; got != strippedSecret {
+		t.Errorf("stripped CHAP secret = %q, want %q", got, strippedSecret)
+	}
+	if got := stripped.PublishContext[disk.ISCSIIQN]; got != "iqn.2015-12.com.oracleiaas:chap" {
+		t.Errorf("stripped IQN = %q, want unchanged", got)
+	}
+	if got := req.PublishContext[disk.ISCSICHAPSECRET]; got != "secret" {
+		t.Errorf("request CHAP secret = %q, want unchanged", got)
+	}
+
+	resp := &csi.ControllerPublishVolumeResponse{PublishContext: map[string]string{disk.ISCSIIQN: "iqn.2015-12.com.oracleiaas:iscsi"}}
+	if got := stripPublishContextSecrets(resp); got != resp {
+		t.Errorf("stripPublishContextSecrets() = %v, want the message without secrets unchanged", got)
+	}
+}
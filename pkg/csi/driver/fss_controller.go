@@ -59,6 +59,8 @@ var (
 
 const (
 	serviceAccountTokenExpiry = 3600
+	// fssMaximumFileSystemSize is the maximum size of a file system, 8 EB.
+	fssMaximumFileSystemSize int64 = 8 * 1000 * 1000 * 1000 * 1000 * 1000 * 1000
 )
 
 var ServiceAccountTokenExpiry = int64(serviceAccountTokenExpiry)
@@ -974,6 +976,9 @@ func (d *FSSControllerDriver) ControllerGetCapabilities(ctx context.Context, req
 	} {
 		caps = append(caps, newCap(capability))
 	}
+	if d.capacityEnabled() {
+		caps = append(caps, newCap(csi.ControllerServiceCapability_RPC_GET_CAPACITY))
+	}
 	resp := &csi.ControllerGetCapabilitiesResponse{
 		Capabilities: caps,
 	}
@@ -1158,8 +1163,34 @@ func (d *FSSControllerDriver) ListVolumes(ctx context.Context, request *csi.List
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
+// GetCapacity returns the maximum size of a file system if a file system can
+// still be created in the availability domain of the storage class, and 0
+// otherwise. File systems are only limited in number.
 func (d *FSSControllerDriver) GetCapacity(ctx context.Context, request *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+	if !d.capacityEnabled() {
+		return nil, status.Error(codes.Unimplemented, "")
+	}
+
+	compartmentID, ok := request.Parameters["compartmentOcid"]
+	if !ok {
+		compartmentID = d.config.CompartmentID
+	}
+	availabilityDomain, ok := request.Parameters["availabilityDomain"]
+	if !ok {
+		availabilityDomain = topologyAvailabilityDomain(request.AccessibleTopology)
+	}
+
+	available, err := d.availableCapacity(ctx, client.FileSystemLimitsService, client.FileSystemCountLimit,
+		compartmentID, availabilityDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &csi.GetCapacityResponse{}
+	if available > 0 {
+		resp.AvailableCapacity = fssMaximumFileSystemSize
+	}
+	return resp, nil
 }
 
 func (d *FSSControllerDriver) CreateSnapshot(ctx context.Context, request *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
@@ -401,7 +401,8 @@ func (p *MockProvisionerClient) FSS(ociClientConfig *client.OCIClientConfig) cli
 }
 
 type MockFSSProvisionerClient struct {
-	Storage *MockFileStorageClient
+	Storage      *MockFileStorageClient
+	LimitsClient client.LimitsInterface
 }
 
 func (m MockFSSProvisionerClient) Compute() client.ComputeInterface {
@@ -437,6 +438,10 @@ func (m MockFSSProvisionerClient) Identity(ociClientConfig *client.OCIClientConf
 	return &MockIdentityClient{}
 }
 
+func (m MockFSSProvisionerClient) Limits() client.LimitsInterface {
+	return m.LimitsClient
+}
+
 func NewFSSClientProvisioner(pcData client.Interface, storage *MockFileStorageClient) client.Interface {
 	return &MockFSSProvisionerClient{Storage: storage}
 }
@@ -54,6 +54,15 @@ func (d *Driver) GetPluginCapabilities(ctx context.Context, req *csi.GetPluginCa
 				Type: &accessibilityConstraints,
 			},
 		}
+		if d.enableControllerServer {
+			capabilities = append(capabilities, &csi.PluginCapability{
+				Type: &csi.PluginCapability_Service_{
+					Service: &csi.PluginCapability_Service{
+						Type: csi.PluginCapability_Service_GROUP_CONTROLLER_SERVICE,
+					},
+				},
+			})
+		}
 	} else {
 		capabilities = []*csi.PluginCapability{
 			{
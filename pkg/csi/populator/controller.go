@@ -0,0 +1,423 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// primePrefix is the prefix of the names of the prime PVCs, provisioned
+	// and populated in place of the PVCs, and of the populator pods
+	primePrefix = "populate-"
+	// populatedPVCLabel is the label of the populator pods with the UID of
+	// the populated PVC
+	populatedPVCLabel = "volume.oci.oracle.com/populated-pvc"
+	// selectedNodeAnnotation is the annotation of the node selected by the
+	// scheduler for the PVCs of WaitForFirstConsumer storage classes
+	selectedNodeAnnotation = "volume.kubernetes.io/selected-node"
+
+	// populatorCommand is the command of the populator pods
+	populatorCommand = "/usr/local/bin/oci-volume-populator"
+	// MountPath is the path the Filesystem volumes are mounted at in the
+	// populator pods
+	MountPath = "/mnt/data"
+	// DevicePath is the path of the device of the Block volumes in the
+	// populator pods
+	DevicePath = "/dev/block"
+	// configMountPath is the path the config secret is mounted at in the
+	// populator pods
+	configMountPath = "/etc/oci/"
+
+	populateStartedReason   = "PopulateStarted"
+	populateFailedReason    = "PopulateFailed"
+	populateSucceededReason = "PopulateSucceeded"
+)
+
+// Config is the configuration of the populator controller.
+type Config struct {
+	// Image is the image of the populator pods, with the oci-volume-populator
+	// command
+	Image string
+	// Namespace is the namespace of the prime PVCs and populator pods
+	Namespace string
+	// ConfigSecret is the secret of the cloud-provider configuration mounted
+	// in the populator pods for the credentials of Object Storage, the
+	// Object Storage requests are not signed if empty
+	ConfigSecret string
+	// Provisioners are the provisioners of the storage classes of the
+	// populated PVCs
+	Provisioners []string
+	// ResyncPeriod is the period the PVCs are synced at
+	ResyncPeriod time.Duration
+}
+
+// Controller populates the PVCs whose dataSourceRef is an
+// OCIObjectStoragePopulator. For every such PVC it provisions a prime PVC of
+// the same storage class in the populator namespace, runs a pod which
+// populates the prime PVC from Object Storage, and then rebinds the
+// persistent volume of the prime PVC to the PVC.
+type Controller struct {
+	kubeClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
+	logger        *zap.SugaredLogger
+	config        Config
+	recorder      record.EventRecorder
+}
+
+// NewController returns a populator controller.
+func NewController(logger *zap.SugaredLogger, kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, config Config) *Controller {
+	return &Controller{
+		kubeClient:    kubeClient,
+		dynamicClient: dynamicClient,
+		logger:        logger.With("component", "populator"),
+		config:        config,
+	}
+}
+
+// Run syncs the PVCs every resync period until stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&v1core.EventSinkImpl{Interface: c.kubeClient.CoreV1().Events("")})
+	defer eventBroadcaster.Shutdown()
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "oci-volume-populator"})
+
+	factory := informers.NewSharedInformerFactory(c.kubeClient, 5*time.Minute)
+	pvcInformer := factory.Core().V1().PersistentVolumeClaims()
+	go pvcInformer.Informer().Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, pvcInformer.Informer().HasSynced) {
+		c.logger.Error("Timed out waiting for the PVC informer to sync, the populator is not started.")
+		return
+	}
+
+	c.logger.With("image", c.config.Image, "namespace", c.config.Namespace).Info("Starting the volume populator.")
+	wait.Until(func() {
+		c.syncAll(context.Background(), pvcInformer.Lister())
+	}, c.config.ResyncPeriod, stopCh)
+}
+
+// syncAll syncs the PVCs populated by the controller.
+func (c *Controller) syncAll(ctx context.Context, pvcLister listersv1.PersistentVolumeClaimLister) {
+	pvcs, err := pvcLister.List(labels.Everything())
+	if err != nil {
+		c.logger.With(zap.Error(err)).Error("Failed to list PVCs.")
+		return
+	}
+	for _, pvc := range pvcs {
+		if !isPopulated(pvc) {
+			continue
+		}
+		if err := c.syncPVC(ctx, pvc); err != nil {
+			c.logger.With(zap.Error(err)).With("pvc", pvc.Namespace+"/"+pvc.Name).Error("Failed to populate PVC, will retry.")
+		}
+	}
+}
+
+// isPopulated returns true if the dataSourceRef of the PVC is an
+// OCIObjectStoragePopulator.
+func isPopulated(pvc *v1.PersistentVolumeClaim) bool {
+	ref := pvc.Spec.DataSourceRef
+	return ref != nil && ref.APIGroup != nil && *ref.APIGroup == GroupName && ref.Kind == Kind
+}
+
+// syncPVC moves the population of the PVC one step forward.
+func (c *Controller) syncPVC(ctx context.Context, pvc *v1.PersistentVolumeClaim) error {
+	primeName := primePrefix + string(pvc.UID)
+	log := c.logger.With("pvc", pvc.Namespace+"/"+pvc.Name, "primePVC", c.config.Namespace+"/"+primeName)
+
+	if pvc.Spec.VolumeName != "" {
+		// the PVC is bound to the populated volume
+		return c.cleanup(ctx, primeName)
+	}
+
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return nil
+	}
+	sc, err := c.kubeClient.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get storage class %s", *pvc.Spec.StorageClassName)
+	}
+	if !c.handlesProvisioner(sc.Provisioner) {
+		return nil
+	}
+	nodeName := ""
+	if sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		if nodeName = pvc.Annotations[selectedNodeAnnotation]; nodeName == "" {
+			// waiting for the scheduling of a consumer
+			return nil
+		}
+	}
+
+	namespace := pvc.Namespace
+	if ref := pvc.Spec.DataSourceRef; ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = *ref.Namespace
+	}
+	if namespace != pvc.Namespace {
+		granted, err := c.referenceGranted(ctx, pvc.Namespace, namespace, pvc.Spec.DataSourceRef.Name)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			c.eventf(pvc, v1.EventTypeWarning, populateFailedReason, "No ReferenceGrant of namespace %s allows the reference to %s %s/%s", namespace, Kind, namespace, pvc.Spec.DataSourceRef.Name)
+			return nil
+		}
+	}
+	u, err := c.dynamicClient.Resource(GroupVersionResource).Namespace(namespace).Get(ctx, pvc.Spec.DataSourceRef.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		c.eventf(pvc, v1.EventTypeWarning, populateFailedReason, "%s %s/%s not found", Kind, namespace, pvc.Spec.DataSourceRef.Name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s %s/%s", Kind, namespace, pvc.Spec.DataSourceRef.Name)
+	}
+	populator, err := fromUnstructured(u)
+	if err != nil {
+		return err
+	}
+	block := pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == v1.PersistentVolumeBlock
+	if err := populator.Spec.validate(block); err != nil {
+		c.eventf(pvc, v1.EventTypeWarning, populateFailedReason, "Invalid %s %s/%s: %v", Kind, namespace, populator.Name, err)
+		return nil
+	}
+
+	prime, err := c.kubeClient.CoreV1().PersistentVolumeClaims(c.config.Namespace).Get(ctx, primeName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		prime, err = c.kubeClient.CoreV1().PersistentVolumeClaims(c.config.Namespace).Create(ctx, primePVC(pvc, primeName, c.config.Namespace, nodeName), metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to create the prime PVC")
+		}
+		log.Info("Created the prime PVC.")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get the prime PVC")
+	}
+
+	pod, err := c.kubeClient.CoreV1().Pods(c.config.Namespace).Get(ctx, primeName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		pod = c.populatorPod(pvc, populator, primeName, nodeName, block)
+		if _, err := c.kubeClient.CoreV1().Pods(c.config.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			return errors.Wrap(err, "failed to create the populator pod")
+		}
+		log.Info("Created the populator pod.")
+		c.eventf(pvc, v1.EventTypeNormal, populateStartedReason, "Populating from %s %s/%s", Kind, namespace, populator.Name)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get the populator pod")
+	}
+
+	switch pod.Status.Phase {
+	case v1.PodFailed:
+		c.eventf(pvc, v1.EventTypeWarning, populateFailedReason, "Populator pod %s/%s failed: %s", pod.Namespace, pod.Name, terminationMessage(pod))
+		// the populator pod is created again at the next sync
+		if err := c.kubeClient.CoreV1().Pods(c.config.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrap(err, "failed to delete the failed populator pod")
+		}
+		return nil
+	case v1.PodSucceeded:
+		return c.rebind(ctx, log, pvc, prime)
+	}
+	return nil
+}
+
+// rebind binds the persistent volume of the populated prime PVC to the PVC.
+func (c *Controller) rebind(ctx context.Context, log *zap.SugaredLogger, pvc, prime *v1.PersistentVolumeClaim) error {
+	if prime.Spec.VolumeName == "" {
+		return nil
+	}
+	pv, err := c.kubeClient.CoreV1().PersistentVolumes().Get(ctx, prime.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get persistent volume %s", prime.Spec.VolumeName)
+	}
+	if pv.Spec.ClaimRef != nil && pv.Spec.ClaimRef.UID == pvc.UID {
+		// waiting for the PV controller to bind the PVC
+		return nil
+	}
+	pv.Spec.ClaimRef = &v1.ObjectReference{
+		APIVersion:      "v1",
+		Kind:            "PersistentVolumeClaim",
+		Namespace:       pvc.Namespace,
+		Name:            pvc.Name,
+		UID:             pvc.UID,
+		ResourceVersion: pvc.ResourceVersion,
+	}
+	if _, err := c.kubeClient.CoreV1().PersistentVolumes().Update(ctx, pv, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to rebind persistent volume %s", pv.Name)
+	}
+	log.With("pv", pv.Name).Info("Rebound the populated persistent volume to the PVC.")
+	c.eventf(pvc, v1.EventTypeNormal, populateSucceededReason, "Populated persistent volume %s", pv.Name)
+	return nil
+}
+
+// referenceGranted returns true if a ReferenceGrant of the namespace of the
+// populator allows the PVCs of the namespace of the PVC to reference it.
+func (c *Controller) referenceGranted(ctx context.Context, pvcNamespace, namespace, name string) (bool, error) {
+	grants, err := c.dynamicClient.Resource(ReferenceGrantResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		// the ReferenceGrant CRD is not installed
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list the ReferenceGrants of namespace %s", namespace)
+	}
+	for _, u := range grants.Items {
+		grant := referenceGrant{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &grant); err != nil {
+			c.logger.With(zap.Error(err)).With("referenceGrant", namespace+"/"+u.GetName()).Warn("Failed to convert ReferenceGrant, ignoring it.")
+			continue
+		}
+		if grant.allows(pvcNamespace, name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cleanup deletes the populator pod and the prime PVC of a populated PVC.
+func (c *Controller) cleanup(ctx context.Context, primeName string) error {
+	err := c.kubeClient.CoreV1().Pods(c.config.Namespace).Delete(ctx, primeName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "failed to delete the populator pod")
+	}
+	err = c.kubeClient.CoreV1().PersistentVolumeClaims(c.config.Namespace).Delete(ctx, primeName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "failed to delete the prime PVC")
+	}
+	return nil
+}
+
+func (c *Controller) handlesProvisioner(provisioner string) bool {
+	for _, p := range c.config.Provisioners {
+		if p == provisioner {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) eventf(pvc *v1.PersistentVolumeClaim, eventType, reason, messageFmt string, args ...interface{}) {
+	if c.recorder != nil {
+		c.recorder.Eventf(pvc, eventType, reason, messageFmt, args...)
+	}
+}
+
+// primePVC returns the prime PVC provisioned and populated in place of the
+// PVC.
+func primePVC(pvc *v1.PersistentVolumeClaim, name, namespace, nodeName string) *v1.PersistentVolumeClaim {
+	prime := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{populatedPVCLabel: string(pvc.UID)},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      pvc.Spec.AccessModes,
+			Resources:        pvc.Spec.Resources,
+			StorageClassName: pvc.Spec.StorageClassName,
+			VolumeMode:       pvc.Spec.VolumeMode,
+		},
+	}
+	if nodeName != "" {
+		prime.Annotations = map[string]string{selectedNodeAnnotation: nodeName}
+	}
+	return prime
+}
+
+// populatorPod returns the pod populating the prime PVC.
+func (c *Controller) populatorPod(pvc *v1.PersistentVolumeClaim, populator *OCIObjectStoragePopulator, name, nodeName string, block bool) *v1.Pod {
+	spec := populator.Spec
+	args := []string{
+		"--object-storage-namespace=" + spec.Namespace,
+		"--bucket=" + spec.Bucket,
+		"--region=" + spec.Region,
+		"--endpoint=" + spec.Endpoint,
+	}
+	container := v1.Container{
+		Name:                     "populate",
+		Image:                    c.config.Image,
+		Command:                  []string{populatorCommand},
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+	}
+	if block {
+		args = append(args, "--image="+spec.Image, "--device-path="+DevicePath)
+		container.VolumeDevices = []v1.VolumeDevice{{Name: "data", DevicePath: DevicePath}}
+	} else {
+		args = append(args, "--prefix="+spec.Prefix, "--mount-path="+MountPath)
+		container.VolumeMounts = []v1.VolumeMount{{Name: "data", MountPath: MountPath}}
+	}
+	container.Args = args
+
+	automountServiceAccountToken := false
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.config.Namespace,
+			Labels:    map[string]string{populatedPVCLabel: string(pvc.UID)},
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+			NodeName:      nodeName,
+			// the populator pods do not use the Kubernetes API
+			AutomountServiceAccountToken: &automountServiceAccountToken,
+			Containers:                   []v1.Container{container},
+			Volumes: []v1.Volume{{
+				Name: "data",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+				},
+			}},
+		},
+	}
+	if c.config.ConfigSecret != "" {
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts,
+			v1.VolumeMount{Name: "config", MountPath: configMountPath, ReadOnly: true})
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name:         "config",
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: c.config.ConfigSecret}},
+		})
+	}
+	return pod
+}
+
+// terminationMessage returns the termination message of the populator
+// container.
+func terminationMessage(pod *v1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Message != "" {
+			return status.State.Terminated.Message
+		}
+	}
+	return fmt.Sprintf("pod phase %s", pod.Status.Phase)
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testProvisioner = "blockvolume.csi.oraclecloud.com"
+
+func newTestController(t *testing.T, bindingMode storagev1.VolumeBindingMode, spec map[string]interface{}) (*Controller, *fake.Clientset) {
+	return newTestControllerWithObjects(t, bindingMode, newTestPopulator("default", spec))
+}
+
+// newTestControllerWithObjects returns a controller whose dynamic client
+// serves the populators and ReferenceGrants.
+func newTestControllerWithObjects(t *testing.T, bindingMode storagev1.VolumeBindingMode, objects ...runtime.Object) (*Controller, *fake.Clientset) {
+	kubeClient := fake.NewSimpleClientset(&storagev1.StorageClass{
+		ObjectMeta:        metav1.ObjectMeta{Name: "oci-bv"},
+		Provisioner:       testProvisioner,
+		VolumeBindingMode: &bindingMode,
+	})
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			GroupVersionResource:   Kind + "List",
+			ReferenceGrantResource: "ReferenceGrantList",
+		}, objects...)
+	c := NewController(zap.S(), kubeClient, dynamicClient, Config{
+		Image:        "cloud-provider-oci:test",
+		Namespace:    "kube-system",
+		ConfigSecret: "oci-volume-provisioner",
+		Provisioners: []string{testProvisioner},
+	})
+	return c, kubeClient
+}
+
+func newTestPopulator(namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": GroupName + "/v1alpha1",
+		"kind":       Kind,
+		"metadata":   map[string]interface{}{"name": "models", "namespace": namespace},
+		"spec":       spec,
+	}}
+}
+
+func newTestReferenceGrant(namespace, fromNamespace, toName string) *unstructured.Unstructured {
+	to := map[string]interface{}{"group": GroupName, "kind": Kind}
+	if toName != "" {
+		to["name"] = toName
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": ReferenceGrantResource.Group + "/" + ReferenceGrantResource.Version,
+		"kind":       "ReferenceGrant",
+		"metadata":   map[string]interface{}{"name": "populators", "namespace": namespace},
+		"spec": map[string]interface{}{
+			"from": []interface{}{map[string]interface{}{"group": "", "kind": "PersistentVolumeClaim", "namespace": fromNamespace}},
+			"to":   []interface{}{to},
+		},
+	}}
+}
+
+func newTestPVC(volumeMode v1.PersistentVolumeMode) *v1.PersistentVolumeClaim {
+	apiGroup := GroupName
+	storageClass := "oci-bv"
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default", UID: "uid-1", ResourceVersion: "7"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			StorageClassName: &storageClass,
+			VolumeMode:       &volumeMode,
+			DataSourceRef:    &v1.TypedObjectReference{APIGroup: &apiGroup, Kind: Kind, Name: "models"},
+		},
+	}
+}
+
+func TestSyncPVC(t *testing.T) {
+	ctx := context.Background()
+	c, kubeClient := newTestController(t, storagev1.VolumeBindingImmediate, map[string]interface{}{
+		"namespace": "ns", "bucket": "models", "prefix": "llm/",
+	})
+	pvc := newTestPVC(v1.PersistentVolumeFilesystem)
+
+	// the prime PVC and the populator pod are created
+	if err := c.syncPVC(ctx, pvc); err != nil {
+		t.Fatalf("syncPVC() failed: %v", err)
+	}
+	prime, err := kubeClient.CoreV1().PersistentVolumeClaims("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("prime PVC not created: %v", err)
+	}
+	if prime.Spec.DataSourceRef != nil || *prime.Spec.StorageClassName != "oci-bv" {
+		t.Errorf("prime PVC spec = %+v, want the storage class of the PVC without data source", prime.Spec)
+	}
+	pod, err := kubeClient.CoreV1().Pods("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("populator pod not created: %v", err)
+	}
+	wantArgs := []string{"--object-storage-namespace=ns", "--bucket=models", "--region=", "--endpoint=", "--prefix=llm/", "--mount-path=" + MountPath}
+	if !reflect.DeepEqual(pod.Spec.Containers[0].Args, wantArgs) {
+		t.Errorf("populator pod args = %v, want %v", pod.Spec.Containers[0].Args, wantArgs)
+	}
+	if token := pod.Spec.AutomountServiceAccountToken; token == nil || *token {
+		t.Errorf("populator pod mounts a service account token")
+	}
+	if len(pod.Spec.Volumes) != 2 || pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName != "populate-uid-1" ||
+		pod.Spec.Volumes[1].Secret.SecretName != "oci-volume-provisioner" {
+		t.Errorf("populator pod volumes = %+v, want the prime PVC and the config secret", pod.Spec.Volumes)
+	}
+
+	// the populated volume is rebound to the PVC
+	prime.Spec.VolumeName = "pv-1"
+	if _, err := kubeClient.CoreV1().PersistentVolumeClaims("kube-system").Update(ctx, prime, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	pod.Status.Phase = v1.PodSucceeded
+	if _, err := kubeClient.CoreV1().Pods("kube-system").Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kubeClient.CoreV1().PersistentVolumes().Create(ctx, &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec:       v1.PersistentVolumeSpec{ClaimRef: &v1.ObjectReference{Namespace: "kube-system", Name: "populate-uid-1"}},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.syncPVC(ctx, pvc); err != nil {
+		t.Fatalf("syncPVC() failed: %v", err)
+	}
+	pv, err := kubeClient.CoreV1().PersistentVolumes().Get(ctx, "pv-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref := pv.Spec.ClaimRef; ref.Namespace != "default" || ref.Name != "data" || ref.UID != "uid-1" {
+		t.Errorf("claimRef = %+v, want the PVC", ref)
+	}
+
+	// the prime PVC and the populator pod are deleted once the PVC is bound
+	pvc.Spec.VolumeName = "pv-1"
+	if err := c.syncPVC(ctx, pvc); err != nil {
+		t.Fatalf("syncPVC() failed: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Pods("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("populator pod not deleted: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().PersistentVolumeClaims("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("prime PVC not deleted: %v", err)
+	}
+}
+
+func TestSyncPVCFailedPod(t *testing.T) {
+	ctx := context.Background()
+	c, kubeClient := newTestController(t, storagev1.VolumeBindingImmediate, map[string]interface{}{
+		"namespace": "ns", "bucket": "images", "image": "disk.img",
+	})
+	pvc := newTestPVC(v1.PersistentVolumeBlock)
+	if err := c.syncPVC(ctx, pvc); err != nil {
+		t.Fatalf("syncPVC() failed: %v", err)
+	}
+	pod, err := kubeClient.CoreV1().Pods("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("populator pod not created: %v", err)
+	}
+	if devices := pod.Spec.Containers[0].VolumeDevices; len(devices) != 1 || devices[0].DevicePath != DevicePath {
+		t.Errorf("populator pod devices = %+v, want the prime PVC at %s", devices, DevicePath)
+	}
+
+	// the failed populator pod is deleted to be created again
+	pod.Status.Phase = v1.PodFailed
+	if _, err := kubeClient.CoreV1().Pods("kube-system").Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.syncPVC(ctx, pvc); err != nil {
+		t.Fatalf("syncPVC() failed: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Pods("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("failed populator pod not deleted: %v", err)
+	}
+}
+
+func TestSyncPVCNotPopulated(t *testing.T) {
+	tests := []struct {
+		name        string
+		bindingMode storagev1.VolumeBindingMode
+		volumeMode  v1.PersistentVolumeMode
+		spec        map[string]interface{}
+	}{
+		{
+			name:        "waiting for first consumer",
+			bindingMode: storagev1.VolumeBindingWaitForFirstConsumer,
+			volumeMode:  v1.PersistentVolumeFilesystem,
+			spec:        map[string]interface{}{"namespace": "ns", "bucket": "models"},
+		},
+		{
+			name:        "block volume without image",
+			bindingMode: storagev1.VolumeBindingImmediate,
+			volumeMode:  v1.PersistentVolumeBlock,
+			spec:        map[string]interface{}{"namespace": "ns", "bucket": "models", "prefix": "llm/"},
+		},
+		{
+			name:        "filesystem volume with image",
+			bindingMode: storagev1.VolumeBindingImmediate,
+			volumeMode:  v1.PersistentVolumeFilesystem,
+			spec:        map[string]interface{}{"namespace": "ns", "bucket": "images", "image": "disk.img"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, kubeClient := newTestController(t, tt.bindingMode, tt.spec)
+			if err := c.syncPVC(ctx, newTestPVC(tt.volumeMode)); err != nil {
+				t.Fatalf("syncPVC() failed: %v", err)
+			}
+			if _, err := kubeClient.CoreV1().PersistentVolumeClaims("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+				t.Errorf("prime PVC created: %v", err)
+			}
+		})
+	}
+}
+
+func TestSyncPVCCrossNamespace(t *testing.T) {
+	spec := map[string]interface{}{"namespace": "ns", "bucket": "models"}
+	tests := []struct {
+		name          string
+		grant         runtime.Object
+		wantPopulated bool
+	}{
+		{
+			name: "no ReferenceGrant",
+		},
+		{
+			name:  "ReferenceGrant of another namespace",
+			grant: newTestReferenceGrant("shared", "other", ""),
+		},
+		{
+			name:  "ReferenceGrant of another populator",
+			grant: newTestReferenceGrant("shared", "default", "other"),
+		},
+		{
+			name:          "ReferenceGrant of the populator",
+			grant:         newTestReferenceGrant("shared", "default", "models"),
+			wantPopulated: true,
+		},
+		{
+			name:          "ReferenceGrant of all populators",
+			grant:         newTestReferenceGrant("shared", "default", ""),
+			wantPopulated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			objects := []runtime.Object{newTestPopulator("shared", spec)}
+			if tt.grant != nil {
+				objects = append(objects, tt.grant)
+			}
+			c, kubeClient := newTestControllerWithObjects(t, storagev1.VolumeBindingImmediate, objects...)
+			pvc := newTestPVC(v1.PersistentVolumeFilesystem)
+			namespace := "shared"
+			pvc.Spec.DataSourceRef.Namespace = &namespace
+			if err := c.syncPVC(ctx, pvc); err != nil {
+				t.Fatalf("syncPVC() failed: %v", err)
+			}
+			_, err := kubeClient.CoreV1().PersistentVolumeClaims("kube-system").Get(ctx, "populate-uid-1", metav1.GetOptions{})
+			if populated := err == nil; populated != tt.wantPopulated {
+				t.Errorf("prime PVC created = %v, want %v", populated, tt.wantPopulated)
+			}
+		})
+	}
+}
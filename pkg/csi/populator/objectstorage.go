@@ -0,0 +1,136 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/pkg/errors"
+)
+
+// objectStorageEndpointTemplate is the template of the Object Storage
+// endpoints of the regions
+const objectStorageEndpointTemplate = "https://objectstorage.{region}.{secondLevelDomain}"
+
+// Object is an object of a bucket.
+type Object struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// listObjectsResponse is the body of the ListObjects responses.
+type listObjectsResponse struct {
+	Objects       []Object `json:"objects"`
+	NextStartWith string   `json:"nextStartWith"`
+}
+
+// ObjectStorageClient lists and downloads the objects of a bucket with the
+// Object Storage REST API.
+type ObjectStorageClient struct {
+	endpoint   string
+	namespace  string
+	bucket     string
+	signer     common.HTTPRequestSigner
+	httpClient *http.Client
+}
+
+// NewObjectStorageClient returns a client of the bucket. The endpoint of the
+// region is used if endpoint is empty. Requests are not signed if signer is
+// nil, e.g. for local stand-ins of Object Storage.
+func NewObjectStorageClient(endpoint, region, namespace, bucket string, signer common.HTTPRequestSigner) *ObjectStorageClient {
+	if endpoint == "" {
+		endpoint = common.StringToRegion(region).EndpointForTemplate("objectstorage", objectStorageEndpointTemplate)
+	}
+	return &ObjectStorageClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		namespace:  namespace,
+		bucket:     bucket,
+		signer:     signer,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// ListObjects lists the objects of the bucket whose names start with the
+// prefix.
+func (c *ObjectStorageClient) ListObjects(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	start := ""
+	for {
+		query := url.Values{"fields": {"name,size"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if start != "" {
+			query.Set("start", start)
+		}
+		resp, err := c.do(ctx, c.bucketURL()+"/o?"+query.Encode())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list objects")
+		}
+		var page listObjectsResponse
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode the objects")
+		}
+		objects = append(objects, page.Objects...)
+		if start = page.NextStartWith; start == "" {
+			return objects, nil
+		}
+	}
+}
+
+// GetObject returns the content of the object, to be closed by the caller.
+func (c *ObjectStorageClient) GetObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, c.bucketURL()+"/o/"+url.PathEscape(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get object %s", name)
+	}
+	return resp.Body, nil
+}
+
+func (c *ObjectStorageClient) bucketURL() string {
+	return fmt.Sprintf("%s/n/%s/b/%s", c.endpoint, url.PathEscape(c.namespace), url.PathEscape(c.bucket))
+}
+
+// do sends a signed GET request, returning an error for non 2xx responses.
+func (c *ObjectStorageClient) do(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if c.signer != nil {
+		if err := c.signer.Sign(req); err != nil {
+			return nil, errors.Wrap(err, "failed to sign the request")
+		}
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
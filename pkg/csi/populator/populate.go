@@ -0,0 +1,88 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// PopulateFilesystem copies the objects of the bucket whose names start with
+// the prefix as files into the directory, the file paths being the object
+// names without the prefix.
+func PopulateFilesystem(ctx context.Context, logger *zap.SugaredLogger, c *ObjectStorageClient, prefix, dir string) error {
+	objects, err := c.ListObjects(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		relPath := strings.TrimLeft(strings.TrimPrefix(object.Name, prefix), "/")
+		if relPath == "" || strings.HasSuffix(object.Name, "/") {
+			// folder markers
+			continue
+		}
+		path := filepath.Join(dir, filepath.FromSlash(relPath))
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(filepath.Separator)) {
+			return errors.Errorf("object %s is outside of the volume", object.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := copyObject(ctx, c, object.Name, path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC); err != nil {
+			return err
+		}
+		logger.With("object", object.Name, "path", path, "size", object.Size).Info("Copied object.")
+	}
+	logger.With("objects", len(objects), "prefix", prefix).Info("Populated the filesystem.")
+	return nil
+}
+
+// PopulateBlock writes the disk image object to the device.
+func PopulateBlock(ctx context.Context, logger *zap.SugaredLogger, c *ObjectStorageClient, image, device string) error {
+	if err := copyObject(ctx, c, image, device, os.O_WRONLY); err != nil {
+		return err
+	}
+	logger.With("image", image, "device", device).Info("Populated the device.")
+	return nil
+}
+
+// copyObject streams the object to the file opened with the flags.
+func copyObject(ctx context.Context, c *ObjectStorageClient, name, path string, flag int) error {
+	body, err := c.GetObject(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	f, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(f, body); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to copy object %s to %s", name, path)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(f.Close())
+}
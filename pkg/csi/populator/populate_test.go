@@ -0,0 +1,144 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// newObjectStorageStandIn returns a local stand-in of the Object Storage
+// ListObjects and GetObject APIs serving the objects of the bucket, one
+// object per page of ListObjects.
+func newObjectStorageStandIn(t *testing.T, namespace, bucket string, objects map[string]string) *httptest.Server {
+	bucketPath := "/n/" + namespace + "/b/" + bucket + "/o"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, err := url.PathUnescape(r.URL.EscapedPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path == bucketPath {
+			var names []string
+			for name := range objects {
+				if strings.HasPrefix(name, r.URL.Query().Get("prefix")) && name >= r.URL.Query().Get("start") {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+			resp := listObjectsResponse{}
+			if len(names) > 0 {
+				resp.Objects = []Object{{Name: names[0], Size: int64(len(objects[names[0]]))}}
+			}
+			if len(names) > 1 {
+				resp.NextStartWith = names[1]
+			}
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+		content, ok := objects[strings.TrimPrefix(path, bucketPath+"/")]
+		if !ok {
+			http.Error(w, "object not found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPopulateFilesystem(t *testing.T) {
+	server := newObjectStorageStandIn(t, "ns", "models", map[string]string{
+		"llm/":                 "",
+		"llm/config.json":      "{}",
+		"llm/weights/shard-01": "weights",
+		"other/file":           "other",
+	})
+	dir := t.TempDir()
+	c := NewObjectStorageClient(server.URL, "", "ns", "models", nil)
+
+	if err := PopulateFilesystem(context.Background(), zap.S(), c, "llm/", dir); err != nil {
+		t.Fatalf("PopulateFilesystem() failed: %v", err)
+	}
+
+	want := map[string]string{"config.json": "{}", "weights/shard-01": "weights"}
+	got := map[string]string{}
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		rel, _ := filepath.Rel(dir, path)
+		got[filepath.ToSlash(rel)] = string(content)
+		return err
+	})
+	if len(got) != len(want) {
+		t.Fatalf("populated files = %v, want %v", got, want)
+	}
+	for path, content := range want {
+		if got[path] != content {
+			t.Errorf("file %s = %q, want %q", path, got[path], content)
+		}
+	}
+}
+
+func TestPopulateFilesystemOutsideOfVolume(t *testing.T) {
+	server := newObjectStorageStandIn(t, "ns", "models", map[string]string{"../escape": "x"})
+	c := NewObjectStorageClient(server.URL, "", "ns", "models", nil)
+
+	if err := PopulateFilesystem(context.Background(), zap.S(), c, "", t.TempDir()); err == nil {
+		t.Error("PopulateFilesystem() succeeded, want error for an object outside of the volume")
+	}
+}
+
+func TestPopulateBlock(t *testing.T) {
+	server := newObjectStorageStandIn(t, "ns", "images", map[string]string{"disk.img": "disk image"})
+	device := filepath.Join(t.TempDir(), "block")
+	if err := os.WriteFile(device, make([]byte, 32), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c := NewObjectStorageClient(server.URL, "", "ns", "images", nil)
+
+	if err := PopulateBlock(context.Background(), zap.S(), c, "disk.img", device); err != nil {
+		t.Fatalf("PopulateBlock() failed: %v", err)
+	}
+	content, err := os.ReadFile(device)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(content), "disk image") || len(content) != 32 {
+		t.Errorf("device content = %q, want the image written at the start of the device", content)
+	}
+
+	if err := PopulateBlock(context.Background(), zap.S(), c, "missing.img", device); err == nil {
+		t.Error("PopulateBlock() of a missing image succeeded, want error")
+	}
+}
+
+func TestNewObjectStorageClientEndpoint(t *testing.T) {
+	c := NewObjectStorageClient("", "us-ashburn-1", "ns", "bucket", nil)
+	if want := "https://objectstorage.us-ashburn-1.oraclecloud.com/n/ns/b/bucket"; c.bucketURL() != want {
+		t.Errorf("bucketURL() = %s, want %s", c.bucketURL(), want)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// GroupName is the API group of the OCIObjectStoragePopulator CRD
+	GroupName = "volume.oci.oracle.com"
+	// Kind is the kind of the OCIObjectStoragePopulator CRD
+	Kind = "OCIObjectStoragePopulator"
+)
+
+// GroupVersionResource is the resource of the OCIObjectStoragePopulator CRD.
+var GroupVersionResource = schema.GroupVersionResource{
+	Group:    GroupName,
+	Version:  "v1alpha1",
+	Resource: "ociobjectstoragepopulators",
+}
+
+// ReferenceGrantResource is the resource of the Gateway API ReferenceGrants,
+// which allow the PVCs of a namespace to reference the populators of another
+// namespace.
+var ReferenceGrantResource = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Version:  "v1beta1",
+	Resource: "referencegrants",
+}
+
+// OCIObjectStoragePopulator is the data source of the PVCs populated from OCI
+// Object Storage, referenced by their dataSourceRef.
+type OCIObjectStoragePopulator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OCIObjectStoragePopulatorSpec `json:"spec"`
+}
+
+// OCIObjectStoragePopulatorSpec is the Object Storage location the volumes
+// are populated from.
+type OCIObjectStoragePopulatorSpec struct {
+	// Namespace is the Object Storage namespace of the bucket
+	Namespace string `json:"namespace"`
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// Prefix is the prefix of the objects copied as files into the
+	// filesystem of Filesystem volumes, the file paths being the object names
+	// without the prefix. All the objects of the bucket are copied if empty.
+	Prefix string `json:"prefix,omitempty"`
+	// Image is the name of the disk image object written to the device of
+	// Block volumes.
+	Image string `json:"image,omitempty"`
+	// Region is the region of the bucket, the region of the cluster if empty.
+	Region string `json:"region,omitempty"`
+	// Endpoint overrides the Object Storage endpoint of the region, e.g. for
+	// private endpoints.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// referenceGrant is the part of a ReferenceGrant the populator checks.
+type referenceGrant struct {
+	Spec referenceGrantSpec `json:"spec"`
+}
+
+type referenceGrantSpec struct {
+	From []referenceGrantFrom `json:"from"`
+	To   []referenceGrantTo   `json:"to"`
+}
+
+type referenceGrantFrom struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+type referenceGrantTo struct {
+	Group string  `json:"group"`
+	Kind  string  `json:"kind"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// allows returns true if the grant allows the PVCs of the namespace to
+// reference the populator.
+func (g referenceGrant) allows(pvcNamespace, populatorName string) bool {
+	from := false
+	for _, f := range g.Spec.From {
+		if f.Group == "" && f.Kind == "PersistentVolumeClaim" && f.Namespace == pvcNamespace {
+			from = true
+			break
+		}
+	}
+	if !from {
+		return false
+	}
+	for _, t := range g.Spec.To {
+		if t.Group == GroupName && t.Kind == Kind && (t.Name == nil || *t.Name == "" || *t.Name == populatorName) {
+			return true
+		}
+	}
+	return false
+}
+
+// fromUnstructured converts the unstructured populator returned by the
+// dynamic client.
+func fromUnstructured(u *unstructured.Unstructured) (*OCIObjectStoragePopulator, error) {
+	populator := &OCIObjectStoragePopulator{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), populator); err != nil {
+		return nil, errors.Wrapf(err, "failed to convert %s %s/%s", Kind, u.GetNamespace(), u.GetName())
+	}
+	return populator, nil
+}
+
+// validate checks the populator spec for the volume mode of the PVC.
+func (s OCIObjectStoragePopulatorSpec) validate(block bool) error {
+	if s.Namespace == "" || s.Bucket == "" {
+		return errors.New("namespace and bucket are required")
+	}
+	if block && s.Image == "" {
+		return errors.New("image is required to populate Block volumes")
+	}
+	if !block && s.Image != "" {
+		return errors.New("image is only supported for Block volumes, prefix is used for Filesystem volumes")
+	}
+	return nil
+}
@@ -202,6 +202,8 @@ func getISCSIAttachment(attachment core.VolumeAttachment) (*core.IScsiVolumeAtta
 // node.
 func (d OCIFlexvolumeDriver) Attach(logger *zap.SugaredLogger, opts flexvolume.Options, nodeName string) flexvolume.DriverStatus {
 	startTime := time.Now()
+	// The driver exits right after the call, push the buffered metrics first.
+	defer d.metricPusher.Close()
 	var errorType string
 	var fvdMetricDimension string
 	logger = logger.With("nodeName", nodeName)
@@ -288,7 +290,7 @@ func (d OCIFlexvolumeDriver) Attach(logger *zap.SugaredLogger, opts flexvolume.O
 	}
 	// volume not attached to any instance, proceed with volume attachment
 	logger.With("volumeID", volumeOCID, "instanceID", *instance.Id).Info("Attaching volume to instance")
-	attachment, err = c.Compute().AttachVolume(ctx, *instance.Id, volumeOCID, false)
+	attachment, err = c.Compute().AttachVolume(ctx, *instance.Id, volumeOCID, false, false, false)
 	if err != nil {
 		errorType = util.GetError(err)
 		fvdMetricDimension = util.GetMetricDimensionForComponent(errorType, util.FVDStorageType)
@@ -328,6 +330,8 @@ func (d OCIFlexvolumeDriver) Attach(logger *zap.SugaredLogger, opts flexvolume.O
 // Detach detaches the volume from the worker node.
 func (d OCIFlexvolumeDriver) Detach(logger *zap.SugaredLogger, pvOrVolumeName, nodeName string) flexvolume.DriverStatus {
 	startTime := time.Now()
+	// The driver exits right after the call, push the buffered metrics first.
+	defer d.metricPusher.Close()
 	logger = logger.With("node", nodeName, "volume", pvOrVolumeName)
 	logger.Info("Looking for volume to detach.")
 	var errorType string
@@ -489,7 +493,7 @@ func (d OCIFlexvolumeDriver) MountDevice(logger *zap.SugaredLogger, mountDir, mo
 	if opts[flexvolume.OptionReadWrite] == "ro" {
 		options = []string{"ro"}
 	}
-	err = iSCSIMounter.FormatAndMount(devicePath, mountDir, opts[flexvolume.OptionFSType], options)
+	err = iSCSIMounter.FormatAndMount(devicePath, mountDir, opts[flexvolume.OptionFSType], options, nil)
 	if err != nil {
 		return flexvolume.Fail(logger, err)
 	}
@@ -46,6 +46,8 @@ const (
 	PVDetach = "PV_DETACH"
 	// PVDetachRWX is the OCI metric suffix for PV detach RWX
 	PVDetachRWX = "PV_DETACH_RWX"
+	// PVForceDetach is the OCI metric suffix for PV force detach from deleted nodes
+	PVForceDetach = "PV_FORCE_DETACH"
 	// PVDelete is the OCI metric suffix for PV delete
 	PVDelete = "PV_DELETE"
 	// PVExpand is the OCI metric suffix for PV Expand
@@ -76,6 +78,10 @@ const (
 	BlockSnapshotDelete = "BSNAP_DELETE"
 	// BlockSnapshotRestore is the OCI metric suffix for Block Volume Snapshot Restore
 	BlockSnapshotRestore = "BSNAP_RESTORE"
+	// BlockGroupSnapshotProvision is the OCI metric suffix for Block Volume Group Snapshot Provision
+	BlockGroupSnapshotProvision = "BGSNAP_PROVISION"
+	// BlockGroupSnapshotDelete is the OCI metric suffix for Block Volume Group Snapshot Delete
+	BlockGroupSnapshotDelete = "BGSNAP_DELETE"
 
 	// FssAllProvision is the OCI metric suffix for FSS end to end provision
 	FssAllProvision = "FSS_ALL_PROVISION"
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -29,11 +30,13 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/monitoring"
 
 	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
-	ociclient "github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
 )
 
 const (
 	metricSubmissionTimeout                = time.Second * 5
+	metricBatchSizeDefault                 = 50
+	metricFlushIntervalDefault             = time.Second * 10
+	metricQueueSizeDefault                 = 1000
 	jitterFactor                           = 0.1
 	configFilePath                         = "/etc/oci/config.yaml"
 	telemetryIngestionServiceName          = "telemetry-ingestion"
@@ -48,24 +51,29 @@ type MonitoringClient interface {
 	PostMetricData(ctx context.Context, request monitoring.PostMetricDataRequest) (response monitoring.PostMetricDataResponse, err error)
 }
 
-// MetricPusher is the wrapper used to push metrics to OCI monitoring service.
+// MetricPusher buffers metrics and pushes them in batches to its sinks, so
+// that callers never wait on a metrics backend. The buffer is bounded, the
+// oldest metrics are dropped when it overflows.
 type MetricPusher struct {
-	// namespace in OCI monitoring
-	namespace string
-	// resourceGroup for OCI monitoring
-	resourceGroup string
-	// compartmentOCID is the the compartment OCID to be
-	// used by OCI monitoring service.
-	compartmentOCID string
-	// metricPrefix is the prefix which should to added to
-	// every metric
-	metricPrefix string
-	// telemetryClient is the monitoring client.
-	telemetryClient MonitoringClient
-	logger          *zap.SugaredLogger
+	sinks         []Sink
+	batchSize     int
+	flushInterval time.Duration
+	queueSize     int
+	logger        *zap.SugaredLogger
+
+	mu      sync.Mutex
+	queue   []Metric
+	dropped int
+
+	flushCh chan struct{}
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	once    sync.Once
 }
 
-// NewMetricPusher creates a new OCI Metric pusher
+// NewMetricPusher creates a new metric pusher for the sinks configured in the
+// metrics section of the cloud-provider configuration. It returns nil if
+// metrics collection is not enabled.
 func NewMetricPusher(logger *zap.SugaredLogger) (*MetricPusher, error) {
 	// we need the following information to push to OCI monitoring service
 	// 1. Compartment for OCI Monitoring
@@ -105,6 +113,26 @@ func NewMetricPusher(logger *zap.SugaredLogger) (*MetricPusher, error) {
 		return nil, nil
 	}
 
+	var sinks []Sink
+	for _, name := range cfg.Metrics.GetSinks() {
+		switch name {
+		case providercfg.MetricsSinkOCI:
+			sink, err := newOCIMonitoringSink(logger, cfg)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case providercfg.MetricsSinkPrometheus:
+			sinks = append(sinks, NewPrometheusSink())
+		case providercfg.MetricsSinkLog:
+			sinks = append(sinks, NewLogSink(logger))
+		}
+	}
+
+	return NewMetricPusherWithSinks(logger, cfg.Metrics, sinks...), nil
+}
+
+func newOCIMonitoringSink(logger *zap.SugaredLogger, cfg *providercfg.Config) (Sink, error) {
 	telemetryEndpoint := common.StringToRegion(cfg.RegionKey).Endpoint(telemetryIngestionServiceName)
 	// dual stack endpoint to be called in case of SingleStack IPv6 only
 	clusterIpFamily, ok := os.LookupEnv(ClusterIpFamilyEnv)
@@ -125,61 +153,136 @@ func NewMetricPusher(logger *zap.SugaredLogger) (*MetricPusher, error) {
 	}
 	client.Host = telemetryEndpoint
 
-	return &MetricPusher{
-		resourceGroup:   cfg.Metrics.ResourceGroup,
-		namespace:       cfg.Metrics.Namespace,
-		compartmentOCID: cfg.Metrics.CompartmentID,
-		telemetryClient: client,
-		logger:          logger,
-		metricPrefix:    cfg.Metrics.Prefix,
-	}, nil
+	return NewOCIMonitoringSink(logger, client, cfg.Metrics), nil
 }
 
-// SendMetricData sends a metric to OCI monitoring.
-// name is the metric name
-// value is the metric value which is float by default
-// dimensions are the custom dimensions to be added to the metric
-func (p *MetricPusher) sendMetricData(name string, value float64, dimensions map[string]string) {
-	now := common.SDKTime{Time: time.Now()}
-
-	dataPoint := monitoring.Datapoint{
-		Value:     &value,
-		Timestamp: &now,
-	}
-
-	metricNameWithPrefix := p.metricPrefix + name
-	metricData := monitoring.MetricDataDetails{
-		Namespace:     &p.namespace,
-		ResourceGroup: &p.resourceGroup,
-		CompartmentId: &p.compartmentOCID,
-		Name:          &metricNameWithPrefix,
-		Dimensions:    dimensions,
-		Datapoints:    []monitoring.Datapoint{dataPoint},
-	}
-
-	metricDataRequest := monitoring.PostMetricDataRequest{
-		PostMetricDataDetails: monitoring.PostMetricDataDetails{
-			MetricData: []monitoring.MetricDataDetails{metricData},
-		},
-		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: ociclient.NewRetryPolicyWithMaxAttempts(2),
-		},
-	}
-	context, cancel := context.WithTimeout(context.Background(), metricSubmissionTimeout)
-	defer cancel()
-	response, err := p.telemetryClient.PostMetricData(context, metricDataRequest)
-	if err != nil {
-		p.logger.With("error", err).Errorf("error occurred while pushing metrics to OCI monitoring")
-		return
+// NewMetricPusherWithSinks creates a metric pusher for the given sinks and
+// starts flushing it in the background. Defaults are used where no (0) value
+// is provided in config.
+func NewMetricPusherWithSinks(logger *zap.SugaredLogger, config *providercfg.MetricsConfig, sinks ...Sink) *MetricPusher {
+	if config == nil {
+		config = &providercfg.MetricsConfig{}
 	}
-	if *response.FailedMetricsCount > 0 {
-		p.logger.With("failedMetrics", response.FailedMetrics).Warnf("metrics could not be submitted successfully")
-	} else {
-		p.logger.With("metricName", metricNameWithPrefix).Info("metrics were submitted successfully")
+	p := &MetricPusher{
+		sinks:         sinks,
+		batchSize:     config.BatchSize,
+		flushInterval: config.FlushInterval,
+		queueSize:     config.QueueSize,
+		logger:        logger,
+		flushCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	if p.batchSize == 0 {
+		p.batchSize = metricBatchSizeDefault
+	}
+	if p.flushInterval == 0 {
+		p.flushInterval = metricFlushIntervalDefault
 	}
+	if p.queueSize == 0 {
+		p.queueSize = metricQueueSizeDefault
+	}
+	logger.Infof("Pushing metrics to %d sink(s): batchSize=%d, flushInterval=%s, queueSize=%d",
+		len(sinks), p.batchSize, p.flushInterval, p.queueSize)
+
+	go p.run()
+	return p
 }
 
-// SendMetricData is used to send the metric
+// enqueue buffers a metric, dropping the oldest buffered metric if the
+// buffer is full.
+func (p *MetricPusher) enqueue(metric Metric) {
+	queueSize := p.queueSize
+	if queueSize == 0 {
+		queueSize = metricQueueSizeDefault
+	}
+
+	p.mu.Lock()
+	if len(p.queue) >= queueSize {
+		p.queue = p.queue[1:]
+		p.dropped++
+		metricsDropped.Inc()
+	}
+	p.queue = append(p.queue, metric)
+	full := p.batchSize > 0 && len(p.queue) >= p.batchSize
+	p.mu.Unlock()
+
+	if full && p.flushCh != nil {
+		select {
+		case p.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// dequeue removes and returns up to batchSize buffered metrics.
+func (p *MetricPusher) dequeue() ([]Metric, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := len(p.queue)
+	if n > p.batchSize {
+		n = p.batchSize
+	}
+	batch := make([]Metric, n)
+	copy(batch, p.queue[:n])
+	p.queue = p.queue[n:]
+	dropped := p.dropped
+	p.dropped = 0
+	return batch, dropped
+}
+
+func (p *MetricPusher) run() {
+	defer close(p.doneCh)
+	ticker := time.NewTicker(p.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.flush()
+		case <-p.flushCh:
+			p.flush()
+		case <-p.stopCh:
+			p.flush()
+			return
+		}
+	}
+}
+
+// flush pushes all buffered metrics to the sinks in batches.
+func (p *MetricPusher) flush() {
+	for {
+		batch, dropped := p.dequeue()
+		if dropped > 0 {
+			p.logger.With("dropped", dropped).Warn("Metrics buffer overflowed, oldest metrics were dropped")
+		}
+		if len(batch) == 0 {
+			return
+		}
+		for _, sink := range p.sinks {
+			ctx, cancel := context.WithTimeout(context.Background(), metricSubmissionTimeout)
+			if err := sink.Push(ctx, batch); err != nil {
+				p.logger.With(zap.Error(err), "sink", sink.Name(), "metrics", len(batch)).Error("Failed to push metrics")
+			}
+			cancel()
+		}
+	}
+}
+
+// Close flushes the buffered metrics and stops the pusher. It should be
+// called before short-lived processes exit.
+func (p *MetricPusher) Close() {
+	if p == nil || p.stopCh == nil {
+		return
+	}
+	p.once.Do(func() {
+		close(p.stopCh)
+		<-p.doneCh
+	})
+}
+
+// SendMetricData is used to send the metric. It does not block on the sinks.
 func SendMetricData(metricPusher *MetricPusher, metric string, value float64, dimensionsMap map[string]string) {
 	if metricPusher == nil {
 		return
@@ -187,7 +290,22 @@ func SendMetricData(metricPusher *MetricPusher, metric string, value float64, di
 
 	// in case of empty dimension value, fill it with unknown
 	dimensions := prepareDimensions(dimensionsMap)
-	metricPusher.sendMetricData(metric, value, dimensions)
+	metricPusher.enqueue(Metric{
+		Name:       metric,
+		Value:      value,
+		Dimensions: copyDimensions(dimensions),
+		Timestamp:  time.Now(),
+	})
+}
+
+// copyDimensions copies dimensions as callers keep modifying their map after
+// SendMetricData returned.
+func copyDimensions(dimensions map[string]string) map[string]string {
+	copied := make(map[string]string, len(dimensions))
+	for k, v := range dimensions {
+		copied[k] = v
+	}
+	return copied
 }
 
 // in case of empty values, store unknown. empty values are not accepted in dimensions
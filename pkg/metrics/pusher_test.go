@@ -0,0 +1,186 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"go.uber.org/zap"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+)
+
+// fakeTelemetryIngestion is a local stand-in for the OCI Monitoring
+// telemetry ingestion endpoint.
+type fakeTelemetryIngestion struct {
+	mu       sync.Mutex
+	requests []monitoring.PostMetricDataDetails
+}
+
+func (f *fakeTelemetryIngestion) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var details monitoring.PostMetricDataDetails
+	if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	f.requests = append(f.requests, details)
+	f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"failedMetricsCount": 0, "failedMetrics": []}`))
+}
+
+func (f *fakeTelemetryIngestion) datapoints() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	count := 0
+	for _, request := range f.requests {
+		for _, metricData := range request.MetricData {
+			count += len(metricData.Datapoints)
+		}
+	}
+	return count
+}
+
+func newTestMonitoringClient(t *testing.T, host string) MonitoringClient {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	cp := common.NewRawConfigurationProvider("ocid1.tenancy.oc1..test", "ocid1.user.oc1..test", "us-phoenix-1",
+		"00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00", string(keyPEM), nil)
+	client, err := monitoring.NewMonitoringClientWithConfigurationProvider(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Host = host
+	return client
+}
+
+var testMetricsConfig = &providercfg.MetricsConfig{
+	CompartmentID: "ocid1.compartment.oc1..test",
+	Namespace:     "test",
+	ResourceGroup: "test",
+	Prefix:        "OCI_CCM_",
+}
+
+func TestOCIMonitoringSink(t *testing.T) {
+	ingestion := &fakeTelemetryIngestion{}
+	server := httptest.NewServer(ingestion)
+	defer server.Close()
+
+	sink := NewOCIMonitoringSink(zap.S(), newTestMonitoringClient(t, server.URL), testMetricsConfig)
+
+	var batch []Metric
+	for i := 0; i < maxMetricStreamsPerRequest+1; i++ {
+		// every metric stream gets two datapoints
+		for j := 0; j < 2; j++ {
+			batch = append(batch, Metric{
+				Name:       PVProvision,
+				Value:      float64(j),
+				Dimensions: map[string]string{ResourceOCIDDimension: string(rune('a' + i))},
+				Timestamp:  time.Now(),
+			})
+		}
+	}
+	if err := sink.Push(context.Background(), batch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ingestion.requests) != 2 {
+		t.Fatalf("expected the metric streams to be split in 2 requests but got %d", len(ingestion.requests))
+	}
+	first := ingestion.requests[0].MetricData
+	if len(first) != maxMetricStreamsPerRequest {
+		t.Errorf("expected %d metric streams in the first request but got %d", maxMetricStreamsPerRequest, len(first))
+	}
+	if *first[0].Name != "OCI_CCM_"+PVProvision || len(first[0].Datapoints) != 2 {
+		t.Errorf("unexpected metric data %s with %d datapoints", *first[0].Name, len(first[0].Datapoints))
+	}
+}
+
+func TestMetricPusherBatches(t *testing.T) {
+	ingestion := &fakeTelemetryIngestion{}
+	server := httptest.NewServer(ingestion)
+	defer server.Close()
+
+	config := *testMetricsConfig
+	config.BatchSize = 5
+	config.FlushInterval = time.Hour
+	pusher := NewMetricPusherWithSinks(zap.S(), &config, NewOCIMonitoringSink(zap.S(), newTestMonitoringClient(t, server.URL), &config))
+
+	for i := 0; i < 5; i++ {
+		SendMetricData(pusher, PVAttach, 1, map[string]string{ComponentDimension: ""})
+	}
+	// reaching the batch size triggers a flush long before the flush interval
+	deadline := time.Now().Add(10 * time.Second)
+	for ingestion.datapoints() < 5 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := ingestion.datapoints(); n != 5 {
+		t.Fatalf("expected 5 datapoints to be pushed but got %d", n)
+	}
+
+	SendMetricData(pusher, PVAttach, 1, map[string]string{})
+	pusher.Close()
+	if n := ingestion.datapoints(); n != 6 {
+		t.Errorf("expected Close to push the remaining datapoint but got %d datapoints", n)
+	}
+}
+
+type recordingSink struct {
+	metrics []Metric
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Push(ctx context.Context, metrics []Metric) error {
+	s.metrics = append(s.metrics, metrics...)
+	return nil
+}
+
+func TestMetricPusherDropsOldest(t *testing.T) {
+	sink := &recordingSink{}
+	pusher := NewMetricPusherWithSinks(zap.S(), &providercfg.MetricsConfig{
+		BatchSize:     100,
+		FlushInterval: time.Hour,
+		QueueSize:     3,
+	}, sink)
+
+	for i := 0; i < 5; i++ {
+		SendMetricData(pusher, PVDelete, float64(i), map[string]string{})
+	}
+	pusher.Close()
+
+	if len(sink.metrics) != 3 {
+		t.Fatalf("expected the buffer to be bounded to 3 metrics but got %d", len(sink.metrics))
+	}
+	if sink.metrics[0].Value != 2 {
+		t.Errorf("expected the oldest metrics to be dropped but the first pushed value is %v", sink.metrics[0].Value)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	k8smetrics "k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	ociclient "github.com/oracle/oci-cloud-controller-manager/pkg/oci/client"
+)
+
+// maxMetricStreamsPerRequest is the number of metric streams OCI Monitoring
+// accepts in a single PostMetricData request.
+const maxMetricStreamsPerRequest = 50
+
+var (
+	metricsDropped = k8smetrics.NewCounter(
+		&k8smetrics.CounterOpts{
+			Name:           "oci_metric_pusher_dropped_total",
+			Help:           "Metrics dropped because the metric pusher buffer overflowed.",
+			StabilityLevel: k8smetrics.ALPHA,
+		},
+	)
+	metricValues = k8smetrics.NewHistogramVec(
+		&k8smetrics.HistogramOpts{
+			Name:           "oci_operation_duration_seconds",
+			Help:           "Values of the operation metrics otherwise pushed to OCI Monitoring, e.g. PV_PROVISION.",
+			Buckets:        k8smetrics.ExponentialBuckets(0.5, 2, 12),
+			StabilityLevel: k8smetrics.ALPHA,
+		},
+		[]string{"name", "component"},
+	)
+)
+
+// Metric is a single datapoint of a named metric.
+type Metric struct {
+	Name       string
+	Value      float64
+	Dimensions map[string]string
+	Timestamp  time.Time
+}
+
+// Sink is a metrics backend the MetricPusher pushes batches of metrics to.
+type Sink interface {
+	// Name identifies the sink in logs.
+	Name() string
+	// Push sends the metrics to the backend.
+	Push(ctx context.Context, metrics []Metric) error
+}
+
+// ociMonitoringSink posts metrics to OCI Monitoring.
+type ociMonitoringSink struct {
+	// namespace in OCI monitoring
+	namespace string
+	// resourceGroup for OCI monitoring
+	resourceGroup string
+	// compartmentOCID is the the compartment OCID to be
+	// used by OCI monitoring service.
+	compartmentOCID string
+	// metricPrefix is the prefix which should to added to
+	// every metric
+	metricPrefix string
+	// telemetryClient is the monitoring client.
+	telemetryClient MonitoringClient
+	logger          *zap.SugaredLogger
+}
+
+// NewOCIMonitoringSink returns a Sink posting metrics to OCI Monitoring with
+// the given client.
+func NewOCIMonitoringSink(logger *zap.SugaredLogger, client MonitoringClient, config *providercfg.MetricsConfig) Sink {
+	return &ociMonitoringSink{
+		resourceGroup:   config.ResourceGroup,
+		namespace:       config.Namespace,
+		compartmentOCID: config.CompartmentID,
+		telemetryClient: client,
+		logger:          logger,
+		metricPrefix:    config.Prefix,
+	}
+}
+
+func (s *ociMonitoringSink) Name() string {
+	return providercfg.MetricsSinkOCI
+}
+
+// Push posts the metrics, with the datapoints of the same metric stream
+// (name and dimensions) grouped together.
+func (s *ociMonitoringSink) Push(ctx context.Context, metrics []Metric) error {
+	var metricData []monitoring.MetricDataDetails
+	streams := map[string]int{}
+	for _, metric := range metrics {
+		value := metric.Value
+		dataPoint := monitoring.Datapoint{
+			Value:     &value,
+			Timestamp: &common.SDKTime{Time: metric.Timestamp},
+		}
+		key := streamKey(metric)
+		if i, ok := streams[key]; ok {
+			metricData[i].Datapoints = append(metricData[i].Datapoints, dataPoint)
+			continue
+		}
+		streams[key] = len(metricData)
+		metricData = append(metricData, monitoring.MetricDataDetails{
+			Namespace:     &s.namespace,
+			ResourceGroup: &s.resourceGroup,
+			CompartmentId: &s.compartmentOCID,
+			Name:          common.String(s.metricPrefix + metric.Name),
+			Dimensions:    metric.Dimensions,
+			Datapoints:    []monitoring.Datapoint{dataPoint},
+		})
+	}
+
+	for start := 0; start < len(metricData); start += maxMetricStreamsPerRequest {
+		end := start + maxMetricStreamsPerRequest
+		if end > len(metricData) {
+			end = len(metricData)
+		}
+		response, err := s.telemetryClient.PostMetricData(ctx, monitoring.PostMetricDataRequest{
+			PostMetricDataDetails: monitoring.PostMetricDataDetails{
+				MetricData: metricData[start:end],
+			},
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: ociclient.NewRetryPolicyWithMaxAttempts(2),
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "error occurred while pushing metrics to OCI monitoring")
+		}
+		if response.FailedMetricsCount != nil && *response.FailedMetricsCount > 0 {
+			s.logger.With("failedMetrics", response.FailedMetrics).Warnf("metrics could not be submitted successfully")
+		} else {
+			s.logger.With("metricStreams", end-start).Info("metrics were submitted successfully")
+		}
+	}
+	return nil
+}
+
+func streamKey(metric Metric) string {
+	keys := make([]string, 0, len(metric.Dimensions))
+	for k := range metric.Dimensions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(metric.Name)
+	for _, k := range keys {
+		b.WriteString("\x00" + k + "=" + metric.Dimensions[k])
+	}
+	return b.String()
+}
+
+// prometheusSink records the metrics in a histogram of the legacy registry.
+// Only the component dimension is kept as a label, the other dimensions such
+// as resource OCIDs would create a series per resource.
+type prometheusSink struct{}
+
+// NewPrometheusSink returns a Sink exposing the metrics on the /metrics
+// endpoint of the component.
+func NewPrometheusSink() Sink {
+	return prometheusSink{}
+}
+
+func (prometheusSink) Name() string {
+	return providercfg.MetricsSinkPrometheus
+}
+
+func (prometheusSink) Push(ctx context.Context, metrics []Metric) error {
+	for _, metric := range metrics {
+		metricValues.WithLabelValues(metric.Name, metric.Dimensions[ComponentDimension]).Observe(metric.Value)
+	}
+	return nil
+}
+
+// logSink writes the metrics to the log.
+type logSink struct {
+	logger *zap.SugaredLogger
+}
+
+// NewLogSink returns a Sink logging every metric.
+func NewLogSink(logger *zap.SugaredLogger) Sink {
+	return &logSink{logger: logger}
+}
+
+func (s *logSink) Name() string {
+	return providercfg.MetricsSinkLog
+}
+
+func (s *logSink) Push(ctx context.Context, metrics []Metric) error {
+	for _, metric := range metrics {
+		s.logger.With("metricName", metric.Name, "value", metric.Value, "dimensions", metric.Dimensions,
+			"timestamp", metric.Timestamp).Info("Metric")
+	}
+	return nil
+}
+
+func init() {
+	legacyregistry.MustRegister(metricsDropped, metricValues)
+}
@@ -59,7 +59,13 @@ type BlockStorageInterface interface {
 	DeleteVolume(ctx context.Context, id string) error
 	GetVolume(ctx context.Context, id string) (*core.Volume, error)
 	GetVolumesByName(ctx context.Context, volumeName, compartmentID string) ([]core.Volume, error)
+	// ListVolumes returns a page of at most limit (if not zero) volumes of the
+	// compartment and the token of the next page, empty for the last page.
+	ListVolumes(ctx context.Context, compartmentID string, limit int, page string) ([]core.Volume, string, error)
 	UpdateVolume(ctx context.Context, volumeId string, details core.UpdateVolumeDetails) (*core.Volume, error)
+	// UpdateVolumeKmsKey changes the KMS key of the volume, kmsKeyID must not
+	// be empty.
+	UpdateVolumeKmsKey(ctx context.Context, volumeId, kmsKeyID string) error
 	GetBootVolume(ctx context.Context, id string) (*core.BootVolume, error)
 
 	AwaitVolumeBackupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeBackup, error)
@@ -67,6 +73,41 @@ type BlockStorageInterface interface {
 	DeleteVolumeBackup(ctx context.Context, id string) error
 	GetVolumeBackup(ctx context.Context, id string) (*core.VolumeBackup, error)
 	GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error)
+	// ListVolumeBackups returns a page of at most limit (if not zero) volume
+	// backups of the compartment, of the volume if volumeID is not empty, and
+	// the token of the next page, empty for the last page.
+	ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error)
+	// CopyVolumeBackup starts copying the volume backup to the destination
+	// region and returns the copy, which is created in the destination region.
+	CopyVolumeBackup(ctx context.Context, volumeBackupID, destinationRegion, displayName string) (*core.VolumeBackup, error)
+	// GetVolumeBackupCopies returns the copies of the source volume backup
+	// that are not deleted or faulty.
+	GetVolumeBackupCopies(ctx context.Context, sourceVolumeBackupID, compartmentID string) ([]core.VolumeBackup, error)
+	// ForRegion returns the block storage client of the region.
+	ForRegion(region string) (BlockStorageInterface, error)
+
+	AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error)
+	CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error)
+	DeleteVolumeGroup(ctx context.Context, id string) error
+	GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error)
+	GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error)
+
+	CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error)
+	DeleteVolumeGroupBackup(ctx context.Context, id string) error
+	GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error)
+	GetVolumeGroupBackupsByName(ctx context.Context, volumeGroupBackupName, compartmentID string) ([]core.VolumeGroupBackup, error)
+
+	// GetVolumeBackupPolicyByName returns the volume backup policy of the
+	// compartment with the display name, the Oracle-defined policy if the
+	// compartmentID is empty.
+	GetVolumeBackupPolicyByName(ctx context.Context, policyName, compartmentID string) (*core.VolumeBackupPolicy, error)
+	AssignVolumeBackupPolicy(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error)
+	GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error
+
+	// GetBlockVolumeReplica returns the block volume replica, which must be
+	// called on the client of the region of the replica.
+	GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error)
 }
 
 func (c *client) GetVolume(ctx context.Context, id string) (*core.Volume, error) {
@@ -297,6 +338,31 @@ func (c *client) UpdateVolume(ctx context.Context, volumeId string, details core
 	return &resp.Volume, nil
 }
 
+func (c *client) UpdateVolumeKmsKey(ctx context.Context, volumeId, kmsKeyID string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "UpdateVolumeKmsKey")
+	}
+
+	resp, err := c.bs.UpdateVolumeKmsKey(ctx, core.UpdateVolumeKmsKeyRequest{
+		VolumeId:                  &volumeId,
+		UpdateVolumeKmsKeyDetails: core.UpdateVolumeKmsKeyDetails{KmsKeyId: &kmsKeyID},
+		RequestMetadata:           c.requestMetadata,
+	})
+	incRequestCounter(err, updateVerb, volumeResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", updateVerb, "resource", volumeResource).
+			With("volumeID", volumeId, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for UpdateVolumeKmsKey call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 func (c *client) DeleteVolume(ctx context.Context, id string) error {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return RateLimitError(true, "DeleteVolume")
@@ -393,6 +459,79 @@ func (c *client) GetVolumesByName(ctx context.Context, volumeName, compartmentID
 	return volumeList, nil
 }
 
+func (c *client) ListVolumes(ctx context.Context, compartmentID string, limit int, page string) ([]core.Volume, string, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, "", RateLimitError(false, "ListVolumes")
+	}
+
+	req := core.ListVolumesRequest{
+		CompartmentId:   &compartmentID,
+		RequestMetadata: c.requestMetadata,
+	}
+	if limit > 0 {
+		req.Limit = &limit
+	}
+	if page != "" {
+		req.Page = &page
+	}
+	resp, err := c.bs.ListVolumes(ctx, req)
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeResource).
+			With("CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded while listing volumes.")
+	}
+	incRequestCounter(err, listVerb, volumeResource)
+
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	nextPage := ""
+	if resp.OpcNextPage != nil {
+		nextPage = *resp.OpcNextPage
+	}
+	return resp.Items, nextPage, nil
+}
+
+func (c *client) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, "", RateLimitError(false, "ListVolumeBackups")
+	}
+
+	req := core.ListVolumeBackupsRequest{
+		CompartmentId:   &compartmentID,
+		RequestMetadata: c.requestMetadata,
+	}
+	if volumeID != "" {
+		req.VolumeId = &volumeID
+	}
+	if limit > 0 {
+		req.Limit = &limit
+	}
+	if page != "" {
+		req.Page = &page
+	}
+	resp, err := c.bs.ListVolumeBackups(ctx, req)
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupResource).
+			With("CompartmentID", compartmentID, "volumeID", volumeID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded while listing volume backups.")
+	}
+	incRequestCounter(err, listVerb, volumeBackupResource)
+
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	nextPage := ""
+	if resp.OpcNextPage != nil {
+		nextPage = *resp.OpcNextPage
+	}
+	return resp.Items, nextPage, nil
+}
+
 func (c *client) GetVolumeBackupsByName(ctx context.Context, snapshotName, compartmentID string) ([]core.VolumeBackup, error) {
 	var page *string
 	volumeBackupList := make([]core.VolumeBackup, 0)
@@ -437,3 +576,96 @@ func (c *client) GetVolumeBackupsByName(ctx context.Context, snapshotName, compa
 
 	return volumeBackupList, nil
 }
+
+func (c *client) CopyVolumeBackup(ctx context.Context, volumeBackupID, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CopyVolumeBackup")
+	}
+
+	resp, err := c.bs.CopyVolumeBackup(ctx, core.CopyVolumeBackupRequest{
+		VolumeBackupId: &volumeBackupID,
+		CopyVolumeBackupDetails: core.CopyVolumeBackupDetails{
+			DestinationRegion: &destinationRegion,
+			DisplayName:       &displayName,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, copyVerb, volumeBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", copyVerb, "resource", volumeBackupResource).
+			With("volumeBackupID", volumeBackupID, "destinationRegion", destinationRegion, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CopyVolumeBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeBackup, nil
+}
+
+func (c *client) GetVolumeBackupCopies(ctx context.Context, sourceVolumeBackupID, compartmentID string) ([]core.VolumeBackup, error) {
+	var page *string
+	volumeBackups := make([]core.VolumeBackup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeBackups")
+		}
+
+		resp, err := c.bs.ListVolumeBackups(ctx, core.ListVolumeBackupsRequest{
+			CompartmentId:        &compartmentID,
+			SourceVolumeBackupId: &sourceVolumeBackupID,
+			Page:                 page,
+			RequestMetadata:      c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, volumeBackupResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupResource).
+				With("sourceVolumeBackupID", sourceVolumeBackupID, "CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume backup copies.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeBackup := range resp.Items {
+			state := volumeBackup.LifecycleState
+			if state != core.VolumeBackupLifecycleStateTerminating &&
+				state != core.VolumeBackupLifecycleStateTerminated &&
+				state != core.VolumeBackupLifecycleStateFaulty {
+				volumeBackups = append(volumeBackups, volumeBackup)
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeBackups, nil
+}
+
+// ForRegion returns a copy of the client that calls the block storage service
+// of the region, sharing the rate limiter of the client.
+func (c *client) ForRegion(region string) (BlockStorageInterface, error) {
+	bs, ok := c.bs.(*core.BlockstorageClient)
+	if !ok {
+		return nil, errors.Errorf("block storage client of region %s is not supported", region)
+	}
+	regionalBs := *bs
+	regionalBs.SetRegion(region)
+
+	return &client{
+		bs:              &regionalBs,
+		requestMetadata: c.requestMetadata,
+		retryConfig:     c.retryConfig,
+		rateLimiter:     c.rateLimiter,
+		circuitBreakers: c.circuitBreakers,
+		logger:          c.logger.With("region", region),
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+func (c *client) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetBlockVolumeReplica")
+	}
+
+	resp, err := c.bs.GetBlockVolumeReplica(ctx, core.GetBlockVolumeReplicaRequest{
+		BlockVolumeReplicaId: &id,
+		RequestMetadata:      c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, blockVolumeReplicaResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", blockVolumeReplicaResource).
+			With("blockVolumeReplicaID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetBlockVolumeReplica call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.BlockVolumeReplica, nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	circuitBreakerFailureThresholdDefault = 10
+	circuitBreakerOpenDurationDefault     = 30 * time.Second
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+var (
+	circuitBreakerState = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Name:           "oci_circuit_breaker_state",
+			Help:           "State of the OCI endpoint circuit breaker (0 closed, 1 half-open, 2 open).",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"endpoint"},
+	)
+	circuitBreakerTrips = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_circuit_breaker_trips_total",
+			Help:           "Number of times the OCI endpoint circuit breaker opened.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"endpoint"},
+	)
+	circuitBreakerRejected = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_circuit_breaker_rejected_requests_total",
+			Help:           "OCI API requests failed fast by an open circuit breaker.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"endpoint"},
+	)
+)
+
+// ErrCircuitOpen is returned for OCI API calls which were short-circuited
+// because their service endpoint is considered unhealthy. It is never retried.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// IsCircuitOpen returns true if the given error was produced by an open
+// circuit breaker.
+func IsCircuitOpen(err error) bool {
+	return errors.Is(err, ErrCircuitOpen)
+}
+
+// circuitBreaker tracks the health of a single OCI service endpoint.
+type circuitBreaker struct {
+	endpoint         string
+	failureThreshold int
+	openDuration     time.Duration
+	logger           *zap.SugaredLogger
+	now              func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// allow reports whether a request may be sent to the endpoint.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		b.setState(circuitHalfOpen)
+		b.probing = true
+		return true
+	case circuitHalfOpen:
+		// Only a single probe request is let through while half-open.
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// record updates the breaker with the outcome of a request.
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		if b.state != circuitClosed {
+			b.logger.With("endpoint", b.endpoint).Info("OCI circuit breaker closed, endpoint recovered")
+			b.setState(circuitClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || (b.state == circuitClosed && b.failures >= b.failureThreshold) {
+		b.logger.With("endpoint", b.endpoint, "consecutiveFailures", b.failures, "openDuration", b.openDuration).
+			Warn("OCI circuit breaker opened, failing requests fast")
+		b.openedAt = b.now()
+		b.setState(circuitOpen)
+		circuitBreakerTrips.WithLabelValues(b.endpoint).Inc()
+	}
+}
+
+// release gives up a probe slot without recording an outcome.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) setState(s circuitState) {
+	b.state = s
+	circuitBreakerState.WithLabelValues(b.endpoint).Set(float64(s))
+}
+
+// circuitBreakers holds one circuitBreaker per service endpoint (host).
+type circuitBreakers struct {
+	config *providercfg.CircuitBreakerConfig
+	logger *zap.SugaredLogger
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// newCircuitBreakers returns nil, i.e. no circuit breaking, if config is nil.
+// Defaults are used where no (0) value is provided.
+func newCircuitBreakers(logger *zap.SugaredLogger, config *providercfg.CircuitBreakerConfig) *circuitBreakers {
+	if config == nil {
+		return nil
+	}
+	resolved := *config
+	if resolved.FailureThreshold == 0 {
+		resolved.FailureThreshold = circuitBreakerFailureThresholdDefault
+	}
+	if resolved.OpenDuration == 0 {
+		resolved.OpenDuration = circuitBreakerOpenDurationDefault
+	}
+	logger.Infof("OCI using circuit breaker configuration: failureThreshold=%d, openDuration=%s",
+		resolved.FailureThreshold, resolved.OpenDuration)
+
+	return &circuitBreakers{
+		config:   &resolved,
+		logger:   logger,
+		breakers: map[string]*circuitBreaker{},
+	}
+}
+
+func (cbs *circuitBreakers) get(endpoint string) *circuitBreaker {
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+
+	b, ok := cbs.breakers[endpoint]
+	if !ok {
+		b = &circuitBreaker{
+			endpoint:         endpoint,
+			failureThreshold: cbs.config.FailureThreshold,
+			openDuration:     cbs.config.OpenDuration,
+			logger:           cbs.logger,
+			now:              time.Now,
+		}
+		cbs.breakers[endpoint] = b
+		circuitBreakerState.WithLabelValues(endpoint).Set(float64(circuitClosed))
+	}
+	return b
+}
+
+// wrap installs the circuit breaker in front of the HTTP dispatcher of the
+// given SDK client. It is a no-op if circuit breaking is disabled.
+func (cbs *circuitBreakers) wrap(baseClient *common.BaseClient) {
+	if cbs == nil || baseClient == nil {
+		return
+	}
+	if _, ok := baseClient.HTTPClient.(*circuitBreakerDispatcher); ok {
+		return
+	}
+	baseClient.HTTPClient = &circuitBreakerDispatcher{
+		next:     baseClient.HTTPClient,
+		breakers: cbs,
+	}
+}
+
+// circuitBreakerDispatcher is a common.HTTPRequestDispatcher which fails
+// requests fast while the breaker of their endpoint is open.
+type circuitBreakerDispatcher struct {
+	next     common.HTTPRequestDispatcher
+	breakers *circuitBreakers
+}
+
+func (d *circuitBreakerDispatcher) Do(req *http.Request) (*http.Response, error) {
+	breaker := d.breakers.get(req.URL.Host)
+	if !breaker.allow() {
+		circuitBreakerRejected.WithLabelValues(breaker.endpoint).Inc()
+		return nil, errors.Wrapf(ErrCircuitOpen, "%s %s", req.Method, req.URL.Host)
+	}
+
+	resp, err := d.next.Do(req)
+	if errors.Is(err, context.Canceled) {
+		// The caller gave up, this says nothing about the endpoint.
+		breaker.release()
+		return resp, err
+	}
+	breaker.record(isCircuitBreakerFailure(resp, err))
+	return resp, err
+}
+
+// isCircuitBreakerFailure returns true for responses which indicate that the
+// endpoint itself is unhealthy, i.e. 5xx status codes and timeouts.
+func isCircuitBreakerFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return true
+		}
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+	return resp != nil && resp.StatusCode >= http.StatusInternalServerError
+}
+
+func init() {
+	legacyregistry.MustRegister(circuitBreakerState, circuitBreakerTrips, circuitBreakerRejected)
+}
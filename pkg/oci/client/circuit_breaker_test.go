@@ -0,0 +1,138 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"go.uber.org/zap"
+)
+
+type mockDispatcher struct {
+	statusCode int
+	err        error
+	calls      int
+}
+
+func (m *mockDispatcher) Do(req *http.Request) (*http.Response, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &http.Response{StatusCode: m.statusCode, Request: req}, nil
+}
+
+func newTestRequest(t *testing.T, host string) *http.Request {
+	req, err := http.NewRequest(http.MethodGet, "https://"+host+"/20160918/instances", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestCircuitBreakerDispatcher(t *testing.T) {
+	now := time.Now()
+	breakers := newCircuitBreakers(zap.S(), &providercfg.CircuitBreakerConfig{
+		FailureThreshold: 3,
+		OpenDuration:     time.Minute,
+	})
+	next := &mockDispatcher{statusCode: http.StatusServiceUnavailable}
+	baseClient := &common.BaseClient{HTTPClient: next}
+	breakers.wrap(baseClient)
+	// wrapping twice must not stack dispatchers
+	breakers.wrap(baseClient)
+	breakers.get("iaas.test").now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if _, err := baseClient.HTTPClient.Do(newTestRequest(t, "iaas.test")); err != nil {
+			t.Fatalf("unexpected error before the breaker opened: %v", err)
+		}
+	}
+	if next.calls != 3 {
+		t.Fatalf("expected 3 calls to reach the endpoint but got %d", next.calls)
+	}
+
+	_, err := baseClient.HTTPClient.Do(newTestRequest(t, "iaas.test"))
+	if !IsCircuitOpen(err) {
+		t.Fatalf("expected an open circuit error but got %v", err)
+	}
+	if IsRetryable(err) {
+		t.Errorf("an open circuit error must not be retryable")
+	}
+	if next.calls != 3 {
+		t.Errorf("expected the request to fail fast but the endpoint was called")
+	}
+
+	// other endpoints are not affected
+	if _, err := baseClient.HTTPClient.Do(newTestRequest(t, "lb.test")); err != nil {
+		t.Errorf("unexpected error for a healthy endpoint: %v", err)
+	}
+
+	// after the open duration a single probe is let through, a failure re-opens the breaker
+	now = now.Add(2 * time.Minute)
+	if _, err := baseClient.HTTPClient.Do(newTestRequest(t, "iaas.test")); err != nil {
+		t.Fatalf("expected the probe to be let through but got %v", err)
+	}
+	if _, err := baseClient.HTTPClient.Do(newTestRequest(t, "iaas.test")); !IsCircuitOpen(err) {
+		t.Fatalf("expected the breaker to re-open after a failed probe but got %v", err)
+	}
+
+	// a successful probe closes the breaker
+	now = now.Add(2 * time.Minute)
+	next.statusCode = http.StatusOK
+	for i := 0; i < 5; i++ {
+		if _, err := baseClient.HTTPClient.Do(newTestRequest(t, "iaas.test")); err != nil {
+			t.Fatalf("expected the breaker to be closed but got %v", err)
+		}
+	}
+}
+
+func TestIsCircuitBreakerFailure(t *testing.T) {
+	testCases := map[string]struct {
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		"ok":                {resp: &http.Response{StatusCode: http.StatusOK}, expected: false},
+		"not found":         {resp: &http.Response{StatusCode: http.StatusNotFound}, expected: false},
+		"too many requests": {resp: &http.Response{StatusCode: http.StatusTooManyRequests}, expected: false},
+		"internal error":    {resp: &http.Response{StatusCode: http.StatusInternalServerError}, expected: true},
+		"bad gateway":       {resp: &http.Response{StatusCode: http.StatusBadGateway}, expected: true},
+		"deadline exceeded": {err: context.DeadlineExceeded, expected: true},
+		"other error":       {err: errNotFound, expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := isCircuitBreakerFailure(tc.resp, tc.err); result != tc.expected {
+				t.Errorf("isCircuitBreakerFailure() = %v ; wanted %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNewCircuitBreakersDisabled(t *testing.T) {
+	breakers := newCircuitBreakers(zap.S(), nil)
+	next := &mockDispatcher{statusCode: http.StatusOK}
+	baseClient := &common.BaseClient{HTTPClient: next}
+	breakers.wrap(baseClient)
+	if baseClient.HTTPClient != next {
+		t.Errorf("expected the dispatcher to be left untouched when the circuit breaker is disabled")
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/filestorage"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/limits"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"github.com/pkg/errors"
@@ -49,6 +50,7 @@ type Interface interface {
 	BlockStorage() BlockStorageInterface
 	FSS(*OCIClientConfig) FileStorageInterface
 	Identity(*OCIClientConfig) IdentityInterface
+	Limits() LimitsInterface
 }
 
 type OCIClientConfig struct {
@@ -164,18 +166,41 @@ type blockstorageClient interface {
 	DeleteVolume(ctx context.Context, request core.DeleteVolumeRequest) (response core.DeleteVolumeResponse, err error)
 	ListVolumes(ctx context.Context, request core.ListVolumesRequest) (response core.ListVolumesResponse, err error)
 	UpdateVolume(ctx context.Context, request core.UpdateVolumeRequest) (response core.UpdateVolumeResponse, err error)
+	UpdateVolumeKmsKey(ctx context.Context, request core.UpdateVolumeKmsKeyRequest) (response core.UpdateVolumeKmsKeyResponse, err error)
 	GetBootVolume(ctx context.Context, request core.GetBootVolumeRequest) (response core.GetBootVolumeResponse, err error)
 
 	GetVolumeBackup(ctx context.Context, request core.GetVolumeBackupRequest) (response core.GetVolumeBackupResponse, err error)
 	CreateVolumeBackup(ctx context.Context, request core.CreateVolumeBackupRequest) (response core.CreateVolumeBackupResponse, err error)
 	DeleteVolumeBackup(ctx context.Context, request core.DeleteVolumeBackupRequest) (response core.DeleteVolumeBackupResponse, err error)
 	ListVolumeBackups(ctx context.Context, request core.ListVolumeBackupsRequest) (response core.ListVolumeBackupsResponse, err error)
+	CopyVolumeBackup(ctx context.Context, request core.CopyVolumeBackupRequest) (response core.CopyVolumeBackupResponse, err error)
+
+	GetVolumeGroup(ctx context.Context, request core.GetVolumeGroupRequest) (response core.GetVolumeGroupResponse, err error)
+	CreateVolumeGroup(ctx context.Context, request core.CreateVolumeGroupRequest) (response core.CreateVolumeGroupResponse, err error)
+	DeleteVolumeGroup(ctx context.Context, request core.DeleteVolumeGroupRequest) (response core.DeleteVolumeGroupResponse, err error)
+	ListVolumeGroups(ctx context.Context, request core.ListVolumeGroupsRequest) (response core.ListVolumeGroupsResponse, err error)
+
+	GetVolumeGroupBackup(ctx context.Context, request core.GetVolumeGroupBackupRequest) (response core.GetVolumeGroupBackupResponse, err error)
+	CreateVolumeGroupBackup(ctx context.Context, request core.CreateVolumeGroupBackupRequest) (response core.CreateVolumeGroupBackupResponse, err error)
+	DeleteVolumeGroupBackup(ctx context.Context, request core.DeleteVolumeGroupBackupRequest) (response core.DeleteVolumeGroupBackupResponse, err error)
+	ListVolumeGroupBackups(ctx context.Context, request core.ListVolumeGroupBackupsRequest) (response core.ListVolumeGroupBackupsResponse, err error)
+
+	ListVolumeBackupPolicies(ctx context.Context, request core.ListVolumeBackupPoliciesRequest) (response core.ListVolumeBackupPoliciesResponse, err error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (response core.CreateVolumeBackupPolicyAssignmentResponse, err error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (response core.DeleteVolumeBackupPolicyAssignmentResponse, err error)
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request core.GetVolumeBackupPolicyAssetAssignmentRequest) (response core.GetVolumeBackupPolicyAssetAssignmentResponse, err error)
+
+	GetBlockVolumeReplica(ctx context.Context, request core.GetBlockVolumeReplicaRequest) (response core.GetBlockVolumeReplicaResponse, err error)
 }
 
 type identityClient interface {
 	ListAvailabilityDomains(ctx context.Context, request identity.ListAvailabilityDomainsRequest) (identity.ListAvailabilityDomainsResponse, error)
 }
 
+type limitsClient interface {
+	GetResourceAvailability(ctx context.Context, request limits.GetResourceAvailabilityRequest) (response limits.GetResourceAvailabilityResponse, err error)
+}
+
 // TODO: Uncomment when compartments is available in OCI Go-SDK
 //type compartmentClient interface {
 //	ListAvailabilityDomains(ctx context.Context, request compartments.ListAvailabilityDomainsRequest) (compartments.ListAvailabilityDomainsResponse, error)
@@ -189,10 +214,15 @@ type client struct {
 	filestorage         filestorageClient
 	bs                  blockstorageClient
 	identity            identityClient
+	limits              limitsClient
 	//compartment 		compartmentClient
 
 	requestMetadata common.RequestMetadata
+	// retryConfig is the retry configuration of the cloud-provider config the
+	// retry policy of the requests is built from
+	retryConfig     *providercfg.RetryConfig
 	rateLimiter     RateLimiter
+	circuitBreakers *circuitBreakers
 
 	subnetCache cache.Store
 	logger      *zap.SugaredLogger
@@ -284,8 +314,33 @@ func New(logger *zap.SugaredLogger, cp common.ConfigurationProvider, opRateLimit
 		return nil, errors.Wrap(err, "configuring file storage service client custom transport")
 	}
 
+	limits, err := limits.NewLimitsClientWithConfigurationProvider(cp)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewLimitsClientWithConfigurationProvider")
+	}
+
+	err = configureCustomTransport(logger, &limits.BaseClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "configuring limits service client custom transport")
+	}
+
+	var retryConfig *providercfg.RetryConfig
+	if cloudProviderConfig != nil {
+		retryConfig = cloudProviderConfig.Retry
+	}
+
 	requestMetadata := common.RequestMetadata{
-		RetryPolicy: newRetryPolicy(),
+		RetryPolicy: NewRetryPolicy(logger, retryConfig),
+	}
+
+	var breakers *circuitBreakers
+	if retryConfig != nil {
+		breakers = newCircuitBreakers(logger, retryConfig.CircuitBreaker)
+	}
+	for _, baseClient := range []*common.BaseClient{&compute.BaseClient, &network.BaseClient, &lb.BaseClient,
+		&nlb.BaseClient, &identity.BaseClient, &bs.BaseClient, &fss.BaseClient, &limits.BaseClient} {
+		instrumentDispatcher(baseClient)
+		breakers.wrap(baseClient)
 	}
 
 	loadbalancer := NewLBClient(lb, requestMetadata, opRateLimiter)
@@ -299,10 +354,13 @@ func New(logger *zap.SugaredLogger, cp common.ConfigurationProvider, opRateLimit
 		networkloadbalancer: networkloadbalancer,
 		bs:                  &bs,
 		filestorage:         &fss,
+		limits:              &limits,
 		//compartment:     	 &compartment,
 
 		rateLimiter:     *opRateLimiter,
 		requestMetadata: requestMetadata,
+		retryConfig:     retryConfig,
+		circuitBreakers: breakers,
 
 		subnetCache: cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
 		logger:      logger,
@@ -342,6 +400,9 @@ func (c *client) LoadBalancer(logger *zap.SugaredLogger, lbType string, targetTe
 			return nil
 		}
 
+		instrumentDispatcher(&lb.BaseClient)
+		c.circuitBreakers.wrap(&lb.BaseClient)
+
 		err = configureCustomTransport(logger, &lb.BaseClient)
 		if err != nil {
 			logger.Error("Failed configure custom transport for LB Client! Error:" + err.Error())
@@ -361,6 +422,9 @@ func (c *client) LoadBalancer(logger *zap.SugaredLogger, lbType string, targetTe
 			return nil
 		}
 
+		instrumentDispatcher(&nlb.BaseClient)
+		c.circuitBreakers.wrap(&nlb.BaseClient)
+
 		err = configureCustomTransport(logger, &nlb.BaseClient)
 		if err != nil {
 			logger.Error("Failed configure custom transport for NLB Client! Error:" + err.Error())
@@ -390,6 +454,9 @@ func (c *client) Networking(ociClientConfig *OCIClientConfig) NetworkingInterfac
 			return nil
 		}
 
+		instrumentDispatcher(&network.BaseClient)
+		c.circuitBreakers.wrap(&network.BaseClient)
+
 		err = configureCustomTransport(c.logger, &network.BaseClient)
 		if err != nil {
 			c.logger.Error("Failed configure custom transport for Network Client %v", err)
@@ -399,7 +466,9 @@ func (c *client) Networking(ociClientConfig *OCIClientConfig) NetworkingInterfac
 		return &client{
 			network:         &network,
 			requestMetadata: c.requestMetadata,
+			retryConfig:     c.retryConfig,
 			rateLimiter:     c.rateLimiter,
+			circuitBreakers: c.circuitBreakers,
 			subnetCache:     cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
 			logger:          c.logger,
 		}
@@ -426,6 +495,9 @@ func (c *client) Identity(ociClientConfig *OCIClientConfig) IdentityInterface {
 			return nil
 		}
 
+		instrumentDispatcher(&identity.BaseClient)
+		c.circuitBreakers.wrap(&identity.BaseClient)
+
 		err = configureCustomTransport(c.logger, &identity.BaseClient)
 		if err != nil {
 			c.logger.Error("Failed configure custom transport for Identity Client %v", err)
@@ -451,7 +523,9 @@ func (c *client) Identity(ociClientConfig *OCIClientConfig) IdentityInterface {
 			//compartment: 	     &compartment,
 			identity:        &identity,
 			requestMetadata: c.requestMetadata,
+			retryConfig:     c.retryConfig,
 			rateLimiter:     c.rateLimiter,
+			circuitBreakers: c.circuitBreakers,
 			subnetCache:     cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
 			logger:          c.logger,
 		}
@@ -477,6 +551,9 @@ func (c *client) FSS(ociClientConfig *OCIClientConfig) FileStorageInterface {
 			return nil
 		}
 
+		instrumentDispatcher(&fc.BaseClient)
+		c.circuitBreakers.wrap(&fc.BaseClient)
+
 		err = configureCustomTransport(c.logger, &fc.BaseClient)
 		if err != nil {
 			c.logger.Errorf("Failed configure custom transport for FSS Client %v", err.Error())
@@ -486,7 +563,9 @@ func (c *client) FSS(ociClientConfig *OCIClientConfig) FileStorageInterface {
 		return &client{
 			filestorage:     &fc,
 			requestMetadata: c.requestMetadata,
+			retryConfig:     c.retryConfig,
 			rateLimiter:     c.rateLimiter,
+			circuitBreakers: c.circuitBreakers,
 			subnetCache:     cache.NewTTLStore(subnetCacheKeyFn, time.Duration(24)*time.Hour),
 			logger:          c.logger,
 		}
@@ -498,14 +577,15 @@ func configureCustomTransport(logger *zap.SugaredLogger, baseClient *common.Base
 	return nil
 }
 
-func getDefaultRequestMetadata(existingRequestMetadata common.RequestMetadata) common.RequestMetadata {
-	if existingRequestMetadata.RetryPolicy != nil {
-		return existingRequestMetadata
+// defaultRequestMetadata returns the request metadata of the client, with a
+// retry policy built from the retry configuration of the client if it has none.
+func (c *client) defaultRequestMetadata() common.RequestMetadata {
+	if c.requestMetadata.RetryPolicy != nil {
+		return c.requestMetadata
 	}
-	requestMetadata := common.RequestMetadata{
-		RetryPolicy: newRetryPolicy(),
+	return common.RequestMetadata{
+		RetryPolicy: NewRetryPolicy(nil, c.retryConfig),
 	}
-	return requestMetadata
 }
 
 func getConfigurationProvider(logger *zap.SugaredLogger, tokenRequest *authv1.TokenRequest, rptURL string) (common.ConfigurationProvider, error) {
@@ -36,6 +36,10 @@ type ComputeInterface interface {
 
 	GetSecondaryVNICsForInstance(ctx context.Context, compartmentID, instanceID string) ([]*core.Vnic, error)
 
+	// ListVnicAttachmentsByCompartmentAndAD lists all the VNIC attachments of
+	// the instances in the given compartment and availability domain.
+	ListVnicAttachmentsByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.VnicAttachment, error)
+
 	VolumeAttachmentInterface
 }
 
@@ -86,6 +90,31 @@ func (c *client) ListInstancesByCompartmentAndAD(ctx context.Context, compartmen
 	return instances, nil
 }
 
+func (c *client) ListVnicAttachmentsByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.VnicAttachment, error) {
+	var (
+		page        *string
+		attachments []core.VnicAttachment
+	)
+	for {
+		resp, err := c.listVNICAttachments(ctx, core.ListVnicAttachmentsRequest{
+			AvailabilityDomain: &availabilityDomain,
+			CompartmentId:      &compartmentID,
+			Page:               page,
+			RequestMetadata:    c.requestMetadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, resp.Items...)
+		if page = resp.OpcNextPage; resp.OpcNextPage == nil {
+			break
+		}
+	}
+
+	return attachments, nil
+}
+
 func (c *client) getInstanceByDisplayName(ctx context.Context, compartmentID, displayName string) (*core.Instance, error) {
 	var (
 		page      *string
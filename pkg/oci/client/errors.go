@@ -97,10 +97,6 @@ func RateLimitError(isWrite bool, opName string) error {
 	return errors.Errorf("rate limited(%s) for operation: %s", opType, opName)
 }
 
-func newRetryPolicy() *common.RetryPolicy {
-	return NewRetryPolicyWithMaxAttempts(uint(2))
-}
-
 // NewRetryPolicyWithMaxAttempts returns a RetryPolicy with the specified max retryAttempts
 func NewRetryPolicyWithMaxAttempts(retryAttempts uint) *common.RetryPolicy {
 	isRetryableOperation := func(r common.OCIOperationResponse) bool {
@@ -0,0 +1,77 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/limits"
+	"github.com/pkg/errors"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+const (
+	// BlockStorageLimitsService is the limits service name of block volumes.
+	BlockStorageLimitsService = "block-storage"
+	// TotalStorageGBLimit is the block volume storage limit per availability
+	// domain, in GB.
+	TotalStorageGBLimit = "total-storage-gb"
+
+	// FileSystemLimitsService is the limits service name of file systems.
+	FileSystemLimitsService = "filesystem"
+	// FileSystemCountLimit is the file system count limit per availability
+	// domain.
+	FileSystemCountLimit = "file-system-count"
+)
+
+// LimitsInterface defines the interface to the OCI limits service consumed by
+// the CSI controllers to report the available storage capacity.
+type LimitsInterface interface {
+	// GetResourceAvailability returns the usage and availability of the limit
+	// of the service in the availability domain, for the compartment. The
+	// quotas of the compartment are taken into account.
+	GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error)
+}
+
+func (c *client) Limits() LimitsInterface {
+	return c
+}
+
+func (c *client) GetResourceAvailability(ctx context.Context, serviceName, limitName, compartmentID, availabilityDomain string) (*limits.ResourceAvailability, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetResourceAvailability")
+	}
+
+	resp, err := c.limits.GetResourceAvailability(ctx, limits.GetResourceAvailabilityRequest{
+		ServiceName:        &serviceName,
+		LimitName:          &limitName,
+		CompartmentId:      &compartmentID,
+		AvailabilityDomain: &availabilityDomain,
+		RequestMetadata:    c.requestMetadata,
+	})
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "limits", "verb", getVerb, "resource", limitResource).
+			With("limitName", limitName, "availabilityDomain", availabilityDomain, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetResourceAvailability call.")
+	}
+	incRequestCounter(err, getVerb, limitResource)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.ResourceAvailability, nil
+}
@@ -15,20 +15,62 @@
 package client
 
 import (
+	"context"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
-	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
 )
 
 var (
-	ociRequestCounter = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "oci_requests_total",
-			Help: "OCI API requests total.",
+	ociRequestCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_requests_total",
+			Help:           "OCI API requests total.",
+			StabilityLevel: metrics.ALPHA,
 		},
 		[]string{"resource", "code", "verb"},
 	)
+	ociRequestErrorCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_request_errors_total",
+			Help:           "Failed OCI API requests by HTTP status and OCI error code.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource", "code", "verb", "error_code"},
+	)
+	ociRequestDuration = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "oci_request_duration_seconds",
+			Help:           "Latency of OCI API HTTP requests. Every SDK retry is a separate request.",
+			Buckets:        metrics.ExponentialBuckets(0.05, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"service", "method", "path", "code"},
+	)
+	ociRateLimiterWait = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Name:           "oci_rate_limiter_wait_duration_seconds",
+			Help:           "Time OCI API requests waited on the client side rate limiter.",
+			Buckets:        metrics.ExponentialBuckets(0.001, 4, 10),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"operation"},
+	)
+	ociRateLimitedCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "oci_rate_limited_requests_total",
+			Help:           "OCI API requests rejected by the client side rate limiter.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"operation"},
+	)
 )
 
 type resource string
@@ -61,6 +103,13 @@ const (
 	nsgRuleResource             resource = "network_security_group_rules"
 	publicReservedIPResource    resource = "public_reserved_ip"
 	volumeBackupResource        resource = "volumeBackup"
+	volumeGroupResource         resource = "volumeGroup"
+	volumeGroupBackupResource   resource = "volumeGroupBackup"
+	limitResource               resource = "limit"
+
+	volumeBackupPolicyResource           resource = "volumeBackupPolicy"
+	volumeBackupPolicyAssignmentResource resource = "volumeBackupPolicyAssignment"
+	blockVolumeReplicaResource           resource = "blockVolumeReplica"
 )
 
 type verb string
@@ -71,25 +120,124 @@ const (
 	createVerb verb = "create"
 	updateVerb verb = "update"
 	deleteVerb verb = "delete"
+	copyVerb   verb = "copy"
 )
 
 func incRequestCounter(err error, v verb, r resource) {
 	statusCode := 200
+	errorCode := ""
 	if err != nil {
 		if serviceErr, ok := err.(common.ServiceError); ok {
 			statusCode = serviceErr.GetHTTPStatusCode()
+			errorCode = serviceErr.GetCode()
 		} else {
 			statusCode = 555 // ¯\_(ツ)_/¯
+			errorCode = "ClientError"
 		}
 	}
 
-	ociRequestCounter.With(prometheus.Labels{
+	ociRequestCounter.With(map[string]string{
 		"resource": string(r),
 		"verb":     string(v),
 		"code":     strconv.Itoa(statusCode),
 	}).Inc()
+	if err != nil {
+		ociRequestErrorCounter.WithLabelValues(string(r), strconv.Itoa(statusCode), string(v), errorCode).Inc()
+	}
+}
+
+// instrumentDispatcher installs a dispatcher recording the latency and
+// tracing every HTTP request in front of the HTTP dispatcher of the given SDK
+// client.
+func instrumentDispatcher(baseClient *common.BaseClient) {
+	if baseClient == nil {
+		return
+	}
+	if _, ok := baseClient.HTTPClient.(*metricsDispatcher); ok {
+		return
+	}
+	baseClient.HTTPClient = &metricsDispatcher{next: &tracingDispatcher{next: baseClient.HTTPClient}}
+}
+
+type metricsDispatcher struct {
+	next common.HTTPRequestDispatcher
+}
+
+func (d *metricsDispatcher) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := d.next.Do(req)
+
+	code := "error"
+	if resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	ociRequestDuration.WithLabelValues(serviceName(req.URL.Host), req.Method, requestPath(req.URL), code).
+		Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// serviceName returns the OCI service of an endpoint, e.g. iaas for
+// iaas.us-phoenix-1.oraclecloud.com.
+func serviceName(host string) string {
+	return strings.SplitN(host, ".", 2)[0]
+}
+
+// requestPath returns the path of an OCI API request without the API version
+// and with the resource identifiers replaced, e.g. /instances/{id} for
+// /20160918/instances/ocid1.instance.oc1..aaaa. OCI API paths alternate
+// between collections and identifiers.
+func requestPath(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) > 0 && isAPIVersion(segments[0]) {
+		segments = segments[1:]
+	}
+	for i := 1; i < len(segments); i += 2 {
+		segments[i] = "{id}"
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isAPIVersion(segment string) bool {
+	if len(segment) != 8 {
+		return false
+	}
+	_, err := strconv.Atoi(segment)
+	return err == nil
+}
+
+// instrumentedRateLimiter records the requests rejected by and the time spent
+// waiting on a rate limiter.
+type instrumentedRateLimiter struct {
+	flowcontrol.RateLimiter
+	operation string
+}
+
+func newInstrumentedRateLimiter(rateLimiter flowcontrol.RateLimiter, operation string) flowcontrol.RateLimiter {
+	return &instrumentedRateLimiter{RateLimiter: rateLimiter, operation: operation}
+}
+
+func (r *instrumentedRateLimiter) TryAccept() bool {
+	if r.RateLimiter.TryAccept() {
+		return true
+	}
+	ociRateLimitedCounter.WithLabelValues(r.operation).Inc()
+	return false
+}
+
+func (r *instrumentedRateLimiter) Accept() {
+	start := time.Now()
+	r.RateLimiter.Accept()
+	ociRateLimiterWait.WithLabelValues(r.operation).Observe(time.Since(start).Seconds())
+}
+
+func (r *instrumentedRateLimiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := r.RateLimiter.Wait(ctx)
+	ociRateLimiterWait.WithLabelValues(r.operation).Observe(time.Since(start).Seconds())
+	return err
 }
 
 func init() {
-	prometheus.MustRegister(ociRequestCounter)
+	legacyregistry.MustRegister(ociRequestCounter, ociRequestErrorCounter, ociRequestDuration,
+		ociRateLimiterWait, ociRateLimitedCounter)
 }
@@ -0,0 +1,99 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestRequestPath(t *testing.T) {
+	testCases := map[string]string{
+		"https://iaas.us-phoenix-1.oraclecloud.com/20160918/instances/ocid1.instance.oc1..aaaa":                      "/instances/{id}",
+		"https://iaas.us-phoenix-1.oraclecloud.com/20160918/vnicAttachments/":                                        "/vnicAttachments",
+		"https://iaas.us-phoenix-1.oraclecloud.com/20170115/loadBalancers/ocid1.lb/backendSets/TCP-80/backends/a:80": "/loadBalancers/{id}/backendSets/{id}/backends/{id}",
+		"https://objectstorage.us-phoenix-1.oraclecloud.com/n/namespace/b/bucket/o/object":                           "/n/{id}/b/{id}/o/{id}",
+	}
+	for in, expected := range testCases {
+		u, err := url.Parse(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := requestPath(u); result != expected {
+			t.Errorf("requestPath(%q) = %q ; wanted %q", in, result, expected)
+		}
+	}
+}
+
+func TestMetricsDispatcher(t *testing.T) {
+	next := &mockDispatcher{statusCode: http.StatusNotFound}
+	baseClient := &common.BaseClient{HTTPClient: next}
+	instrumentDispatcher(baseClient)
+	// instrumenting twice must not stack dispatchers
+	instrumentDispatcher(baseClient)
+
+	req, err := http.NewRequest(http.MethodGet, "https://iaas.test/20160918/instances/ocid1.instance.oc1..aaaa", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := baseClient.HTTPClient.Do(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 1 {
+		t.Errorf("expected a single call to reach the endpoint but got %d", next.calls)
+	}
+
+	count, err := testutil.GetHistogramMetricCount(ociRequestDuration.WithLabelValues("iaas", http.MethodGet, "/instances/{id}", "404"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 observation but got %d", count)
+	}
+}
+
+func TestIncRequestCounterErrorCode(t *testing.T) {
+	err := mockServiceError{StatusCode: http.StatusConflict, Code: "IncorrectState"}
+	incRequestCounter(err, updateVerb, instanceResource)
+
+	value, gatherErr := testutil.GetCounterMetricValue(ociRequestErrorCounter.WithLabelValues(string(instanceResource), "409", string(updateVerb), "IncorrectState"))
+	if gatherErr != nil {
+		t.Fatal(gatherErr)
+	}
+	if value != 1 {
+		t.Errorf("expected 1 failed request but got %v", value)
+	}
+}
+
+func TestInstrumentedRateLimiter(t *testing.T) {
+	rateLimiter := newInstrumentedRateLimiter(flowcontrol.NewTokenBucketRateLimiter(1, 1), "test")
+	rateLimiter.TryAccept()
+	if rateLimiter.TryAccept() {
+		t.Fatalf("expected the second request to be rate limited")
+	}
+
+	value, err := testutil.GetCounterMetricValue(ociRateLimitedCounter.WithLabelValues("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 1 {
+		t.Errorf("expected 1 rate limited request but got %v", value)
+	}
+}
@@ -304,7 +304,7 @@ func (c *client) CreatePrivateIp(ctx context.Context, vnicId string) (*core.Priv
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "CreatePrivateIp")
 	}
-	requestMetadata := getDefaultRequestMetadata(c.requestMetadata)
+	requestMetadata := c.defaultRequestMetadata()
 	resp, err := c.network.CreatePrivateIp(ctx, core.CreatePrivateIpRequest{
 		CreatePrivateIpDetails: core.CreatePrivateIpDetails{
 			VnicId: &vnicId,
@@ -347,7 +347,7 @@ func (c *client) CreateNetworkSecurityGroup(ctx context.Context, compartmentId,
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "CreateNetworkSecurityGroup")
 	}
-	requestMetadata := getDefaultRequestMetadata(c.requestMetadata)
+	requestMetadata := c.defaultRequestMetadata()
 
 	resp, err := c.network.CreateNetworkSecurityGroup(ctx, core.CreateNetworkSecurityGroupRequest{
 		CreateNetworkSecurityGroupDetails: core.CreateNetworkSecurityGroupDetails{
@@ -447,7 +447,7 @@ func (c *client) DeleteNetworkSecurityGroup(ctx context.Context, id, etag string
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "DeleteNetworkSecurityGroup")
 	}
-	requestMetadata := getDefaultRequestMetadata(c.requestMetadata)
+	requestMetadata := c.defaultRequestMetadata()
 
 	resp, err := c.network.DeleteNetworkSecurityGroup(ctx, core.DeleteNetworkSecurityGroupRequest{
 		NetworkSecurityGroupId: &id,
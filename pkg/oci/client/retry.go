@@ -0,0 +1,134 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"go.uber.org/zap"
+)
+
+const (
+	retryMaxAttemptsDefault = 2
+	retryBaseDelayDefault   = time.Second
+	retryMaxDelayDefault    = 32 * time.Second
+)
+
+// retryPolicy is the resolved retry configuration for one class of operations.
+type retryPolicy struct {
+	maxAttempts uint
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      float64
+}
+
+func newClassRetryPolicy(config *providercfg.RetryPolicyConfig) retryPolicy {
+	p := retryPolicy{
+		maxAttempts: retryMaxAttemptsDefault,
+		baseDelay:   retryBaseDelayDefault,
+		maxDelay:    retryMaxDelayDefault,
+	}
+	if config == nil {
+		return p
+	}
+	if config.MaxAttempts != 0 {
+		p.maxAttempts = config.MaxAttempts
+	}
+	if config.BaseDelay != 0 {
+		p.baseDelay = config.BaseDelay
+	}
+	if config.MaxDelay != 0 {
+		p.maxDelay = config.MaxDelay
+	}
+	if p.maxDelay < p.baseDelay {
+		p.maxDelay = p.baseDelay
+	}
+	p.jitter = config.Jitter
+	return p
+}
+
+// nextDuration returns the exponential backoff for the given (one-based)
+// attempt, capped at maxDelay and randomised by jitter.
+func (p retryPolicy) nextDuration(attempt uint) time.Duration {
+	delay := float64(p.baseDelay) * math.Pow(2, float64(attempt-1))
+	if delay > float64(p.maxDelay) {
+		delay = float64(p.maxDelay)
+	}
+	if p.jitter > 0 {
+		delay = delay * (1 - p.jitter + 2*p.jitter*rand.Float64())
+	}
+	return time.Duration(delay)
+}
+
+// NewRetryPolicy builds the RetryPolicy used for all OCI API calls from the
+// retry section of the cloud-provider config. Read (GET) operations and write
+// operations are retried according to their own class. Defaults are used where
+// no (0) value is provided.
+func NewRetryPolicy(logger *zap.SugaredLogger, config *providercfg.RetryConfig) *common.RetryPolicy {
+	if config == nil {
+		config = &providercfg.RetryConfig{}
+	}
+	read := newClassRetryPolicy(config.Read)
+	write := newClassRetryPolicy(config.Write)
+
+	if logger != nil {
+		logger.Infof("OCI using read retry configuration: attempts=%d, baseDelay=%s, maxDelay=%s, jitter=%g",
+			read.maxAttempts, read.baseDelay, read.maxDelay, read.jitter)
+		logger.Infof("OCI using write retry configuration: attempts=%d, baseDelay=%s, maxDelay=%s, jitter=%g",
+			write.maxAttempts, write.baseDelay, write.maxDelay, write.jitter)
+	}
+
+	classOf := func(r common.OCIOperationResponse) retryPolicy {
+		if isReadOperation(r) {
+			return read
+		}
+		return write
+	}
+
+	isRetryableOperation := func(r common.OCIOperationResponse) bool {
+		return r.AttemptNumber < classOf(r).maxAttempts && IsRetryable(r.Error)
+	}
+
+	nextDuration := func(r common.OCIOperationResponse) time.Duration {
+		return classOf(r).nextDuration(r.AttemptNumber)
+	}
+
+	maxAttempts := read.maxAttempts
+	if write.maxAttempts > maxAttempts {
+		maxAttempts = write.maxAttempts
+	}
+
+	policy := common.NewRetryPolicy(maxAttempts, isRetryableOperation, nextDuration)
+	return &policy
+}
+
+// isReadOperation reports whether the operation that produced the response was
+// a GET. Responses without an HTTP request (e.g. timeouts) are treated as
+// writes so that they are retried conservatively.
+func isReadOperation(r common.OCIOperationResponse) bool {
+	if r.Response == nil {
+		return false
+	}
+	httpResponse := r.Response.HTTPResponse()
+	if httpResponse == nil || httpResponse.Request == nil {
+		return false
+	}
+	return httpResponse.Request.Method == http.MethodGet
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	providercfg "github.com/oracle/oci-cloud-controller-manager/pkg/cloudprovider/providers/oci/config"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"go.uber.org/zap"
+)
+
+type mockOCIResponse struct {
+	method string
+}
+
+func (m mockOCIResponse) HTTPResponse() *http.Response {
+	return &http.Response{Request: &http.Request{Method: m.method}}
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	retryableErr := mockServiceError{StatusCode: http.StatusTooManyRequests, Code: HTTP429TooManyRequestsCode}
+	config := &providercfg.RetryConfig{
+		Read: &providercfg.RetryPolicyConfig{
+			MaxAttempts: 4,
+			BaseDelay:   time.Second,
+			MaxDelay:    3 * time.Second,
+		},
+		Write: &providercfg.RetryPolicyConfig{
+			MaxAttempts: 2,
+		},
+	}
+	policy := NewRetryPolicy(zap.S(), config)
+
+	if policy.MaximumNumberAttempts != 4 {
+		t.Errorf("expected MaximumNumberAttempts 4 but got %d", policy.MaximumNumberAttempts)
+	}
+
+	testCases := map[string]struct {
+		response      common.OCIOperationResponse
+		shouldRetry   bool
+		expectedDelay time.Duration
+	}{
+		"read is retried": {
+			response:      common.OCIOperationResponse{Response: mockOCIResponse{http.MethodGet}, Error: retryableErr, AttemptNumber: 2},
+			shouldRetry:   true,
+			expectedDelay: 2 * time.Second,
+		},
+		"read backoff is capped": {
+			response:      common.OCIOperationResponse{Response: mockOCIResponse{http.MethodGet}, Error: retryableErr, AttemptNumber: 3},
+			shouldRetry:   true,
+			expectedDelay: 3 * time.Second,
+		},
+		"read stops at max attempts": {
+			response:    common.OCIOperationResponse{Response: mockOCIResponse{http.MethodGet}, Error: retryableErr, AttemptNumber: 4},
+			shouldRetry: false,
+		},
+		"write stops at max attempts": {
+			response:    common.OCIOperationResponse{Response: mockOCIResponse{http.MethodPost}, Error: retryableErr, AttemptNumber: 2},
+			shouldRetry: false,
+		},
+		"write is retried": {
+			response:      common.OCIOperationResponse{Response: mockOCIResponse{http.MethodPut}, Error: retryableErr, AttemptNumber: 1},
+			shouldRetry:   true,
+			expectedDelay: time.Second,
+		},
+		"no response is treated as write": {
+			response:    common.OCIOperationResponse{Error: retryableErr, AttemptNumber: 2},
+			shouldRetry: false,
+		},
+		"success is not retried": {
+			response:    common.OCIOperationResponse{Response: mockOCIResponse{http.MethodGet}, AttemptNumber: 1},
+			shouldRetry: false,
+		},
+		"open circuit is not retried": {
+			response:    common.OCIOperationResponse{Error: ErrCircuitOpen, AttemptNumber: 1},
+			shouldRetry: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := policy.ShouldRetryOperation(tc.response); result != tc.shouldRetry {
+				t.Errorf("ShouldRetryOperation() = %v ; wanted %v", result, tc.shouldRetry)
+			}
+			if !tc.shouldRetry {
+				return
+			}
+			if result := policy.NextDuration(tc.response); result != tc.expectedDelay {
+				t.Errorf("NextDuration() = %s ; wanted %s", result, tc.expectedDelay)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	p := newClassRetryPolicy(&providercfg.RetryPolicyConfig{
+		BaseDelay: 10 * time.Second,
+		MaxDelay:  10 * time.Second,
+		Jitter:    0.5,
+	})
+	for i := 0; i < 100; i++ {
+		d := p.nextDuration(1)
+		if d < 5*time.Second || d > 15*time.Second {
+			t.Fatalf("nextDuration() = %s ; wanted between 5s and 15s", d)
+		}
+	}
+}
+
+func TestNewRetryPolicyDefaults(t *testing.T) {
+	policy := NewRetryPolicy(zap.S(), nil)
+	if policy.MaximumNumberAttempts != retryMaxAttemptsDefault {
+		t.Errorf("expected MaximumNumberAttempts %d but got %d", retryMaxAttemptsDefault, policy.MaximumNumberAttempts)
+	}
+	response := common.OCIOperationResponse{
+		Response:      mockOCIResponse{http.MethodGet},
+		Error:         mockServiceError{StatusCode: http.StatusInternalServerError, Code: HTTP500InternalServerErrorCode},
+		AttemptNumber: 1,
+	}
+	if !policy.ShouldRetryOperation(response) {
+		t.Errorf("expected the first failed attempt to be retried")
+	}
+	if d := policy.NextDuration(response); d != time.Second {
+		t.Errorf("NextDuration() = %s ; wanted 1s", d)
+	}
+}
+
+func TestDefaultRequestMetadata(t *testing.T) {
+	configured := &client{retryConfig: &providercfg.RetryConfig{
+		Read:  &providercfg.RetryPolicyConfig{MaxAttempts: 3},
+		Write: &providercfg.RetryPolicyConfig{MaxAttempts: 6},
+	}}
+	if policy := configured.defaultRequestMetadata().RetryPolicy; policy == nil || policy.MaximumNumberAttempts != 6 {
+		t.Errorf("expected the retry policy of the retry configuration, with 6 attempts, but got %+v", policy)
+	}
+
+	existing := NewRetryPolicy(nil, nil)
+	configured.requestMetadata = common.RequestMetadata{RetryPolicy: existing}
+	if policy := configured.defaultRequestMetadata().RetryPolicy; policy != existing {
+		t.Errorf("expected the retry policy of the request metadata of the client but got %+v", policy)
+	}
+}
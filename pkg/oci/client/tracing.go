@@ -0,0 +1,97 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/tracing"
+)
+
+const opcRequestIDHeader = "opc-request-id"
+
+// tracingDispatcher starts a span for every HTTP request to OCI, child of
+// the span of the request context.
+type tracingDispatcher struct {
+	next common.HTTPRequestDispatcher
+}
+
+func (d *tracingDispatcher) Do(req *http.Request) (*http.Response, error) {
+	path := requestPath(req.URL)
+	v, r := requestVerbAndResource(req.Method, path)
+	ctx, span := tracing.Start(req.Context(), serviceName(req.URL.Host)+" "+req.Method+" "+path,
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.ServerAddress(req.URL.Host),
+		attribute.String("oci.path", path),
+		attribute.String("oci.verb", string(v)),
+		attribute.String("oci.resource", string(r)),
+	)
+
+	resp, err := d.next.Do(req.WithContext(ctx))
+	spanErr := err
+	opcRequestID := req.Header.Get(opcRequestIDHeader)
+	if resp != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if id := resp.Header.Get(opcRequestIDHeader); id != "" {
+			opcRequestID = id
+		}
+		if err == nil && resp.StatusCode >= http.StatusBadRequest {
+			// the SDK turns the response into a ServiceError later on
+			spanErr = errors.New(resp.Status)
+		}
+	}
+	if opcRequestID != "" {
+		span.SetAttributes(attribute.String("oci.opc_request_id", opcRequestID))
+	}
+	tracing.End(span, spanErr)
+	return resp, err
+}
+
+// requestVerbAndResource maps an OCI API request to the verb and resource
+// dimensions of incRequestCounter, e.g. get and instances for
+// GET /instances/{id}. The resource is the collection of the API path.
+func requestVerbAndResource(method, path string) (verb, resource) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	actions := false
+	for len(segments) > 1 && segments[len(segments)-2] == "actions" {
+		// POST /volumeAttachments/{id}/actions/detach
+		actions = true
+		segments = segments[:len(segments)-2]
+	}
+	item := len(segments)%2 == 0
+	if item {
+		segments = segments[:len(segments)-1]
+	}
+	r := resource(segments[len(segments)-1])
+
+	switch {
+	case actions, method == http.MethodPut:
+		return updateVerb, r
+	case method == http.MethodPost:
+		return createVerb, r
+	case method == http.MethodDelete:
+		return deleteVerb, r
+	case item:
+		return getVerb, r
+	default:
+		return listVerb, r
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRequestVerbAndResource(t *testing.T) {
+	testCases := []struct {
+		method   string
+		path     string
+		verb     verb
+		resource resource
+	}{
+		{http.MethodGet, "/instances/{id}", getVerb, "instances"},
+		{http.MethodGet, "/vnicAttachments", listVerb, "vnicAttachments"},
+		{http.MethodPost, "/loadBalancers/{id}/backendSets", createVerb, "backendSets"},
+		{http.MethodPut, "/loadBalancers/{id}/backendSets/{id}", updateVerb, "backendSets"},
+		{http.MethodDelete, "/volumes/{id}", deleteVerb, "volumes"},
+		{http.MethodPost, "/volumeAttachments/{id}/actions/{id}", updateVerb, "volumeAttachments"},
+	}
+	for _, tc := range testCases {
+		v, r := requestVerbAndResource(tc.method, tc.path)
+		if v != tc.verb || r != tc.resource {
+			t.Errorf("requestVerbAndResource(%q, %q) = %q, %q ; wanted %q, %q", tc.method, tc.path, v, r, tc.verb, tc.resource)
+		}
+	}
+}
@@ -84,12 +84,12 @@ func NewRateLimiter(logger *zap.SugaredLogger, config *providercfg.RateLimiterCo
 	}
 
 	rateLimiter := RateLimiter{
-		Reader: flowcontrol.NewTokenBucketRateLimiter(
+		Reader: newInstrumentedRateLimiter(flowcontrol.NewTokenBucketRateLimiter(
 			config.RateLimitQPSRead,
-			config.RateLimitBucketRead),
-		Writer: flowcontrol.NewTokenBucketRateLimiter(
+			config.RateLimitBucketRead), "read"),
+		Writer: newInstrumentedRateLimiter(flowcontrol.NewTokenBucketRateLimiter(
 			config.RateLimitQPSWrite,
-			config.RateLimitBucketWrite),
+			config.RateLimitBucketWrite), "write"),
 	}
 
 	logger.Infof("OCI using read rate limit configuration: QPS=%g, bucket=%d",
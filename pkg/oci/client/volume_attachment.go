@@ -35,11 +35,12 @@ type VolumeAttachmentInterface interface {
 	// ATTACHING or ATTACHED. If no attachments are found, errNotFound is returned.
 	FindVolumeAttachment(ctx context.Context, compartmentID, volumeID string, instanceID *string) (core.VolumeAttachment, error)
 
-	// AttachVolume attaches a block storage volume to the specified instance.
+	// AttachVolume attaches a block storage volume to the specified instance,
+	// with CHAP authentication of the iSCSI sessions if useChap is set.
 	// See https://docs.us-phoenix-1.oraclecloud.com/api/#/en/iaas/20160918/VolumeAttachment/AttachVolume
-	AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable bool) (core.VolumeAttachment, error)
+	AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable, isReadOnly, useChap bool) (core.VolumeAttachment, error)
 
-	AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool) (core.VolumeAttachment, error)
+	AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool, isReadOnly bool) (core.VolumeAttachment, error)
 
 	// WaitForVolumeAttached polls waiting for a OCI block volume to be in the
 	// ATTACHED state.
@@ -57,7 +58,8 @@ type VolumeAttachmentInterface interface {
 	// LOGGED_OUT state.
 	WaitForUHPVolumeLoggedOut(ctx context.Context, attachmentID string) error
 
-	// ListVolumeAttachments returns all non-DETACHED volume attachments
+	// ListVolumeAttachments returns all non-DETACHED volume attachments of the
+	// volume, or of the compartment if volumeID is empty.
 	// If no attachments are found, errNotFound is returned
 	ListVolumeAttachments(ctx context.Context, compartmentID, volumeID string) ([]core.VolumeAttachment, error)
 }
@@ -140,7 +142,7 @@ func (c *client) GetVolumeAttachment(ctx context.Context, id string) (core.Volum
 	return resp.VolumeAttachment, nil
 }
 
-func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable bool) (core.VolumeAttachment, error) {
+func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable, isReadOnly, useChap bool) (core.VolumeAttachment, error) {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "")
 	}
@@ -150,6 +152,12 @@ func (c *client) AttachVolume(ctx context.Context, instanceID, volumeID string,
 		VolumeId:    &volumeID,
 		IsShareable: &isShareable,
 	}
+	if isReadOnly {
+		attachVolumeDetails.IsReadOnly = &isReadOnly
+	}
+	if useChap {
+		attachVolumeDetails.UseChap = &useChap
+	}
 
 	if !IsBootVolume(volumeID) {
 		device, err := c.getDevicePath(ctx, instanceID)
@@ -214,7 +222,7 @@ func (c *client) getDevicePath(ctx context.Context, instanceID string) (*string,
 	return device, nil
 }
 
-func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool) (core.VolumeAttachment, error) {
+func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool, isReadOnly bool) (core.VolumeAttachment, error) {
 	if !c.rateLimiter.Writer.TryAccept() {
 		return nil, RateLimitError(false, "")
 	}
@@ -224,15 +232,20 @@ func (c *client) AttachParavirtualizedVolume(ctx context.Context, instanceID, vo
 		return nil, errors.WithStack(err)
 	}
 
+	var attachVolumeDetails = core.AttachParavirtualizedVolumeDetails{
+		InstanceId:                     &instanceID,
+		VolumeId:                       &volumeID,
+		IsPvEncryptionInTransitEnabled: &isPvEncryptionInTransitEnabled,
+		Device:                         device,
+		IsShareable:                    &isShareable,
+	}
+	if isReadOnly {
+		attachVolumeDetails.IsReadOnly = &isReadOnly
+	}
+
 	resp, err := c.compute.AttachVolume(ctx, core.AttachVolumeRequest{
-		AttachVolumeDetails: core.AttachParavirtualizedVolumeDetails{
-			InstanceId:                     &instanceID,
-			VolumeId:                       &volumeID,
-			IsPvEncryptionInTransitEnabled: &isPvEncryptionInTransitEnabled,
-			Device:                         device,
-			IsShareable:                    &isShareable,
-		},
-		RequestMetadata: c.requestMetadata,
+		AttachVolumeDetails: attachVolumeDetails,
+		RequestMetadata:     c.requestMetadata,
 	})
 
 	incRequestCounter(err, createVerb, volumeAttachmentResource)
@@ -327,12 +340,15 @@ func (c *client) ListVolumeAttachments(ctx context.Context, compartmentID, volum
 			return nil, RateLimitError(false, "ListVolumeAttachments")
 		}
 
-		resp, err := c.compute.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+		req := core.ListVolumeAttachmentsRequest{
 			CompartmentId:   &compartmentID,
-			VolumeId:        &volumeID,
 			Page:            page,
 			RequestMetadata: c.requestMetadata,
-		})
+		}
+		if volumeID != "" {
+			req.VolumeId = &volumeID
+		}
+		resp, err := c.compute.ListVolumeAttachments(ctx, req)
 
 		if resp.OpcRequestId != nil {
 			c.logger.With("service", "compute", "verb", listVerb, "resource", volumeAttachmentResource).
@@ -0,0 +1,156 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+func (c *client) GetVolumeBackupPolicyByName(ctx context.Context, policyName, compartmentID string) (*core.VolumeBackupPolicy, error) {
+	var page *string
+	var compartment *string
+	if compartmentID != "" {
+		compartment = &compartmentID
+	}
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeBackupPolicies")
+		}
+
+		resp, err := c.bs.ListVolumeBackupPolicies(ctx, core.ListVolumeBackupPoliciesRequest{
+			CompartmentId:   compartment,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, volumeBackupPolicyResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeBackupPolicyResource).
+				With("volumeBackupPolicyName", policyName, "CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume backup policies by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for i := range resp.Items {
+			if resp.Items[i].DisplayName != nil && *resp.Items[i].DisplayName == policyName {
+				return &resp.Items[i], nil
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return nil, errors.WithStack(errNotFound)
+}
+
+func (c *client) AssignVolumeBackupPolicy(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.CreateVolumeBackupPolicyAssignment(ctx, core.CreateVolumeBackupPolicyAssignmentRequest{
+		CreateVolumeBackupPolicyAssignmentDetails: core.CreateVolumeBackupPolicyAssignmentDetails{
+			AssetId:  &volumeID,
+			PolicyId: &policyID,
+		},
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeBackupPolicyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeBackupPolicyAssignmentResource).
+			With("volumeID", volumeID, "volumeBackupPolicyID", policyID, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeBackupPolicyAssignment, nil
+}
+
+func (c *client) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	var page *string
+	assignments := make([]core.VolumeBackupPolicyAssignment, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "GetVolumeBackupPolicyAssetAssignment")
+		}
+
+		resp, err := c.bs.GetVolumeBackupPolicyAssetAssignment(ctx, core.GetVolumeBackupPolicyAssetAssignmentRequest{
+			AssetId:         &volumeID,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, getVerb, volumeBackupPolicyAssignmentResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeBackupPolicyAssignmentResource).
+				With("volumeID", volumeID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded for GetVolumeBackupPolicyAssetAssignment call.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		assignments = append(assignments, resp.Items...)
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return assignments, nil
+}
+
+func (c *client) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeBackupPolicyAssignment")
+	}
+
+	resp, err := c.bs.DeleteVolumeBackupPolicyAssignment(ctx, core.DeleteVolumeBackupPolicyAssignmentRequest{
+		PolicyAssignmentId: &id,
+		RequestMetadata:    c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, volumeBackupPolicyAssignmentResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeBackupPolicyAssignmentResource).
+			With("volumeBackupPolicyAssignmentID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeBackupPolicyAssignment call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/oracle/oci-cloud-controller-manager/pkg/util"
+)
+
+func (c *client) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroup(ctx, core.CreateVolumeGroupRequest{
+		CreateVolumeGroupDetails: details,
+		RequestMetadata:          c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupResource).
+			With("volumeGroupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroup")
+	}
+
+	resp, err := c.bs.GetVolumeGroup(ctx, core.GetVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupResource).
+			With("volumeGroupID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeGroup, nil
+}
+
+func (c *client) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	var page *string
+	volumeGroups := make([]core.VolumeGroup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeGroups")
+		}
+
+		resp, err := c.bs.ListVolumeGroups(ctx, core.ListVolumeGroupsRequest{
+			CompartmentId:   &compartmentID,
+			DisplayName:     &volumeGroupName,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, volumeGroupResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeGroupResource).
+				With("volumeGroupName", volumeGroupName, "CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume groups by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeGroup := range resp.Items {
+			state := volumeGroup.LifecycleState
+			if state == core.VolumeGroupLifecycleStateProvisioning ||
+				state == core.VolumeGroupLifecycleStateAvailable {
+				volumeGroups = append(volumeGroups, volumeGroup)
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeGroups, nil
+}
+
+// AwaitVolumeGroupAvailableOrTimeout takes context as timeout
+func (c *client) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	var volumeGroup *core.VolumeGroup
+	if err := wait.PollImmediateUntil(volumePollInterval, func() (bool, error) {
+		var err error
+		volumeGroup, err = c.GetVolumeGroup(ctx, id)
+		if err != nil {
+			if !IsRetryable(err) {
+				return false, err
+			}
+			return false, nil
+		}
+
+		switch state := volumeGroup.LifecycleState; state {
+		case core.VolumeGroupLifecycleStateAvailable:
+			return true, nil
+		case core.VolumeGroupLifecycleStateFaulty,
+			core.VolumeGroupLifecycleStateTerminated,
+			core.VolumeGroupLifecycleStateTerminating:
+			return false, errors.Errorf("volume group did not become available (lifecycleState=%q)", state)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, err
+	}
+
+	return volumeGroup, nil
+}
+
+func (c *client) DeleteVolumeGroup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroup(ctx, core.DeleteVolumeGroupRequest{
+		VolumeGroupId:   &id,
+		RequestMetadata: c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, volumeGroupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupResource).
+			With("volumeGroupID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (c *client) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return nil, RateLimitError(true, "CreateVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.CreateVolumeGroupBackup(ctx, core.CreateVolumeGroupBackupRequest{
+		CreateVolumeGroupBackupDetails: details,
+		RequestMetadata:                c.requestMetadata,
+	})
+	incRequestCounter(err, createVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", createVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupName", *(details.DisplayName), "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for CreateVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	if !c.rateLimiter.Reader.TryAccept() {
+		return nil, RateLimitError(false, "GetVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.GetVolumeGroupBackup(ctx, core.GetVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata,
+	})
+	incRequestCounter(err, getVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", getVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for GetVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &resp.VolumeGroupBackup, nil
+}
+
+func (c *client) GetVolumeGroupBackupsByName(ctx context.Context, volumeGroupBackupName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	var page *string
+	volumeGroupBackups := make([]core.VolumeGroupBackup, 0)
+
+	for {
+		if !c.rateLimiter.Reader.TryAccept() {
+			return nil, RateLimitError(false, "ListVolumeGroupBackups")
+		}
+
+		resp, err := c.bs.ListVolumeGroupBackups(ctx, core.ListVolumeGroupBackupsRequest{
+			CompartmentId:   &compartmentID,
+			DisplayName:     &volumeGroupBackupName,
+			Page:            page,
+			RequestMetadata: c.requestMetadata,
+		})
+		incRequestCounter(err, listVerb, volumeGroupBackupResource)
+
+		if resp.OpcRequestId != nil {
+			c.logger.With("service", "blockstorage", "verb", listVerb, "resource", volumeGroupBackupResource).
+				With("volumeGroupBackupName", volumeGroupBackupName, "CompartmentID", compartmentID, "OpcRequestId", *(resp.OpcRequestId)).
+				With("statusCode", util.GetHttpStatusCode(err)).
+				Info("OPC Request ID recorded while fetching volume group backups by name.")
+		}
+
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		for _, volumeGroupBackup := range resp.Items {
+			state := volumeGroupBackup.LifecycleState
+			if state != core.VolumeGroupBackupLifecycleStateTerminating &&
+				state != core.VolumeGroupBackupLifecycleStateTerminated &&
+				state != core.VolumeGroupBackupLifecycleStateFaulty {
+				volumeGroupBackups = append(volumeGroupBackups, volumeGroupBackup)
+			}
+		}
+
+		if page = resp.OpcNextPage; page == nil {
+			break
+		}
+	}
+
+	return volumeGroupBackups, nil
+}
+
+func (c *client) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	if !c.rateLimiter.Writer.TryAccept() {
+		return RateLimitError(true, "DeleteVolumeGroupBackup")
+	}
+
+	resp, err := c.bs.DeleteVolumeGroupBackup(ctx, core.DeleteVolumeGroupBackupRequest{
+		VolumeGroupBackupId: &id,
+		RequestMetadata:     c.requestMetadata,
+	})
+	incRequestCounter(err, deleteVerb, volumeGroupBackupResource)
+
+	if resp.OpcRequestId != nil {
+		c.logger.With("service", "blockstorage", "verb", deleteVerb, "resource", volumeGroupBackupResource).
+			With("volumeGroupBackupID", id, "OpcRequestId", *(resp.OpcRequestId)).
+			With("statusCode", util.GetHttpStatusCode(err)).
+			Info("OPC Request ID recorded for DeleteVolumeGroupBackup call.")
+	}
+
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// publishContextPropagator carries the trace context in the publish context
+// of a volume, from ControllerPublishVolume to the node RPCs of the volume.
+var publishContextPropagator = propagation.TraceContext{}
+
+// UnaryServerInterceptor starts a span for every RPC. The parent span is the
+// one propagated in the gRPC metadata, e.g. by a CSI sidecar, or otherwise
+// the one in the publish context of the request.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+		}
+		if r, ok := req.(interface{ GetPublishContext() map[string]string }); ok && !trace.SpanContextFromContext(ctx).IsValid() {
+			ctx = publishContextPropagator.Extract(ctx, propagation.MapCarrier(r.GetPublishContext()))
+		}
+
+		service, method := splitFullMethod(info.FullMethod)
+		attributes := []attribute.KeyValue{semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(method)}
+		if r, ok := req.(interface{ GetVolumeId() string }); ok && r.GetVolumeId() != "" {
+			attributes = append(attributes, attribute.String("csi.volume_id", r.GetVolumeId()))
+		}
+		ctx, span := otel.Tracer(instrumentationName).Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attributes...))
+
+		resp, err := handler(ctx, req)
+		span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(status.Code(err))))
+		End(span, err)
+		return resp, err
+	}
+}
+
+// InjectPublishContext adds the trace context of ctx to the publish context
+// of a volume so that the node RPCs of the volume are part of the same trace.
+// The publish context is left unchanged when ctx is not sampled.
+func InjectPublishContext(ctx context.Context, publishContext map[string]string) {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return
+	}
+	publishContextPropagator.Inject(ctx, propagation.MapCarrier(publishContext))
+}
+
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+// metadataCarrier adapts the gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample())))
+	otel.SetTextMapPropagator(publishContextPropagator)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	// the trace of ControllerPublishVolume
+	controllerCtx, controllerSpan := Start(context.Background(), "ControllerPublishVolume")
+	publishContext := map[string]string{"device": "/dev/oracleoci/oraclevdb"}
+	InjectPublishContext(controllerCtx, publishContext)
+	controllerSpan.End()
+
+	// the trace of a sidecar
+	sidecarCtx, sidecarSpan := Start(context.Background(), "sidecar")
+	md := metadata.MD{}
+	otel.GetTextMapPropagator().Inject(sidecarCtx, metadataCarrier(md))
+	sidecarSpan.End()
+
+	testCases := map[string]struct {
+		ctx           context.Context
+		expectedTrace trace.TraceID
+	}{
+		"publish context": {
+			ctx:           context.Background(),
+			expectedTrace: controllerSpan.SpanContext().TraceID(),
+		},
+		"gRPC metadata": {
+			ctx:           metadata.NewIncomingContext(context.Background(), md),
+			expectedTrace: sidecarSpan.SpanContext().TraceID(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var traceID trace.TraceID
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				traceID = trace.SpanContextFromContext(ctx).TraceID()
+				return &csi.NodeStageVolumeResponse{}, nil
+			}
+			req := &csi.NodeStageVolumeRequest{VolumeId: "ocid1.volume.oc1..aaaa", PublishContext: publishContext}
+			info := &grpc.UnaryServerInfo{FullMethod: "/csi.v1.Node/NodeStageVolume"}
+			if _, err := UnaryServerInterceptor()(tc.ctx, req, info, handler); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if traceID != tc.expectedTrace {
+				t.Errorf("expected the RPC to be part of trace %s but got %s", tc.expectedTrace, traceID)
+			}
+		})
+	}
+}
+
+func TestInjectPublishContextNotSampled(t *testing.T) {
+	publishContext := map[string]string{}
+	InjectPublishContext(context.Background(), publishContext)
+	if len(publishContext) != 0 {
+		t.Errorf("expected the publish context to be left unchanged but got %v", publishContext)
+	}
+}
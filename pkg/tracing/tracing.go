@@ -0,0 +1,67 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing provides the OpenTelemetry tracing shared by the cloud
+// controller manager and the CSI drivers. Tracing is disabled unless Setup is
+// called, in which case spans are no-ops.
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/component-base/tracing"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+)
+
+const instrumentationName = "github.com/oracle/oci-cloud-controller-manager"
+
+// Setup exports the spans of the process over OTLP to the collector of the
+// given configuration. The returned function flushes the pending spans and
+// stops the export.
+func Setup(ctx context.Context, serviceName string, config *tracingapi.TracingConfiguration) (func(context.Context) error, error) {
+	if errs := tracingapi.ValidateTracingConfiguration(config, nil, nil); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	tp, err := tracing.NewProvider(ctx, config, nil, []resource.Option{
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithHost(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the tracer provider")
+	}
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(tracing.Propagators())
+	return tp.Shutdown, nil
+}
+
+// Start starts a span of the given name, child of the span of ctx if any.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span, recording err if it is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
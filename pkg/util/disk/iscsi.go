@@ -53,10 +53,23 @@ const (
 
 	LIST_PATHS_COMMAND  = "ls -f /dev/disk/by-path"
 	DISK_BY_PATH_FOLDER = "/dev/disk/by-path/"
+
+	// ISCSICHAPUSERNAME is the map key to get or save the iSCSI CHAP user name
+	ISCSICHAPUSERNAME = "iscsi_chap_username"
+	// ISCSICHAPSECRET is the map key to get or save the iSCSI CHAP secret
+	ISCSICHAPSECRET = "iscsi_chap_secret"
 )
 
 // ErrMountPointNotFound is returned when a given path does not appear to be
 // a mount point.
+const (
+	// ISCSISessionStateLoggedIn is the state of logged in iSCSI sessions
+	ISCSISessionStateLoggedIn = "LOGGED_IN"
+	// iscsiadmNoRecordsExitStatus is the exit status of iscsiadm when there is
+	// no iSCSI session or node record
+	iscsiadmNoRecordsExitStatus = 21
+)
+
 var ErrMountPointNotFound = errors.New("mount point not found")
 
 // diskByPathPattern is the regex for extracting the iSCSI connection details
@@ -73,8 +86,8 @@ type Interface interface {
 	// if the disk is not formatted and it is not being mounted as read-only it
 	// will format it first then mount it. Otherwise, if the disk is already
 	// formatted or it is being mounted as read-only, it will be mounted without
-	// formatting.
-	FormatAndMount(source string, target string, fstype string, options []string) error
+	// formatting. The format options are passed to mkfs when formatting.
+	FormatAndMount(source string, target string, fstype string, options []string, formatOptions []string) error
 
 	//Mount only mounts the disk. In case if formatting is handled by different functionality.
 	// This function doesn't bother for checking the format again.
@@ -110,6 +123,11 @@ type Interface interface {
 	GetDiskFormat(devicePath string) (string, error)
 
 	WaitForPathToExist(path string, maxRetries int) bool
+
+	// SessionLoggedIn returns whether the iSCSI session the device is attached
+	// with is logged in. Devices not attached with iSCSI have no session and
+	// are always logged in.
+	SessionLoggedIn(devicePath string) (bool, error)
 }
 
 // iSCSIMounter implements Interface.
@@ -129,6 +147,10 @@ type Disk struct {
 	IQN     string
 	IscsiIp string
 	Port    int
+	// ChapUsername and ChapSecret are the CHAP credentials of the target,
+	// empty if the target does not require CHAP authentication.
+	ChapUsername string
+	ChapSecret   string
 }
 
 func (sd *Disk) String() string {
@@ -241,6 +263,12 @@ func GetDiskPathFromMountPath(logger *zap.SugaredLogger, mountPath string) ([]st
 	if err != nil {
 		return nil, err
 	}
+	// the disk paths of LUKS encrypted volumes are the ones of their backing device
+	if mapperName, ok := LUKSMapperNameForDevice(mountPoint.Device); ok {
+		if mountPoint.Device, err = LUKSBackingDevice(mapperName); err != nil {
+			return nil, err
+		}
+	}
 	if strings.HasPrefix(mountPoint.Device, "/dev/mapper") {
 		return []string{mountPoint.Device}, nil
 	}
@@ -286,6 +314,17 @@ func GetDiskPathFromBindDeviceFilePath(logger *zap.SugaredLogger, mountPath stri
 	// Convert the device name to the correct path format
 	devicePath := filepath.Join("/dev", deviceName)
 
+	// the disk paths of LUKS encrypted volumes are the ones of their backing device
+	if mapperName, ok := LUKSMapperNameForDevice(devicePath); ok {
+		if devicePath, err = LUKSBackingDevice(mapperName); err != nil {
+			logger.With(zap.Error(err)).Warn("Unable to find the backing device of the LUKS device")
+			return nil, err
+		}
+		if strings.HasPrefix(devicePath, "/dev/mapper") {
+			return []string{devicePath}, nil
+		}
+	}
+
 	// Create a mount.MountPoint struct
 	mountPoint := mount.MountPoint{
 		Path:   mountPath,
@@ -346,6 +385,37 @@ func (c *iSCSIMounter) AddToDB() error {
 
 	c.logger.With("IQN", c.disk.IQN, "target", c.disk.Target()).Info("Added node record to db.")
 
+	if c.disk.ChapUsername != "" {
+		return c.setCHAPCredentials()
+	}
+	return nil
+}
+
+// setCHAPCredentials configures the node record of the target to log in
+// with the CHAP credentials of the disk. They are removed with the node
+// record.
+func (c *iSCSIMounter) setCHAPCredentials() error {
+	c.logger.With("IQN", c.disk.IQN, "target", c.disk.Target()).Info("Configuring CHAP authentication.")
+
+	for _, setting := range [][2]string{
+		{"node.session.auth.authmethod", "CHAP"},
+		{"node.session.auth.username", c.disk.ChapUsername},
+		{"node.session.auth.password", c.disk.ChapSecret},
+	} {
+		_, err := c.iscsiadm(
+			"-m", "node",
+			"-T", c.disk.IQN,
+			"-p", c.disk.Target(),
+			"-o", "update",
+			"-n", setting[0],
+			"-v", setting[1])
+		if err != nil {
+			return fmt.Errorf("iscsi: error configuring %s of target: %v", setting[0], err)
+		}
+	}
+
+	c.logger.With("IQN", c.disk.IQN, "target", c.disk.Target()).Info("Configured CHAP authentication.")
+
 	return nil
 }
 
@@ -442,16 +512,189 @@ func (c *iSCSIMounter) WaitForVolumeLoginOrTimeout(ctx context.Context, multipat
 	return nil
 }
 
-func (c *iSCSIMounter) FormatAndMount(source string, target string, fstype string, options []string) error {
+// SessionLoggedIn returns whether the session of the iSCSI target of the disk,
+// or of the device if the disk is unknown, is logged in.
+func (c *iSCSIMounter) SessionLoggedIn(devicePath string) (bool, error) {
+	sd := c.disk
+	if sd == nil {
+		var err error
+		if sd, err = GetScsiInfo(devicePath); err != nil {
+			return false, err
+		}
+	}
+
+	sessions, err := c.sessions()
+	if err != nil {
+		return false, err
+	}
+	for _, session := range sessions {
+		if session.IQN == sd.IQN && session.Target() == sd.Target() {
+			c.logger.With("IQN", sd.IQN, "target", sd.Target(), "state", session.State).Debug("iSCSI session state.")
+			return session.State == ISCSISessionStateLoggedIn, nil
+		}
+	}
+	return false, nil
+}
+
+// sessions returns the iSCSI sessions of the node.
+// sudo iscsiadm -m session -P 1
+func (c *iSCSIMounter) sessions() ([]ISCSISession, error) {
+	output, err := c.iscsiadm("-m", "session", "-P", "1")
+	if err != nil {
+		if isNoRecordsError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("iscsi: error listing sessions: %v", err)
+	}
+	return parseISCSISessions(output), nil
+}
+
+// nodeRecords returns the iSCSI node records of the node.
+// sudo iscsiadm -m node
+func (c *iSCSIMounter) nodeRecords() ([]Disk, error) {
+	output, err := c.iscsiadm("-m", "node")
+	if err != nil {
+		if isNoRecordsError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("iscsi: error listing node records: %v", err)
+	}
+	return parseISCSINodeRecords(output), nil
+}
+
+// isNoRecordsError returns true if iscsiadm failed because there is no session
+// or node record.
+func isNoRecordsError(err error) bool {
+	exitErr, ok := err.(exec.ExitError)
+	return ok && exitErr.ExitStatus() == iscsiadmNoRecordsExitStatus
+}
+
+// ISCSISession is an iSCSI session of the node to a target.
+type ISCSISession struct {
+	Disk
+	// State is the state of the session, LOGGED_IN, FAILED or FREE
+	State string
+}
+
+// ListISCSISessions returns the iSCSI sessions of the node.
+func ListISCSISessions(logger *zap.SugaredLogger) ([]ISCSISession, error) {
+	c := &iSCSIMounter{runner: exec.New(), logger: logger}
+	return c.sessions()
+}
+
+// ListISCSINodeRecords returns the targets of the iSCSI node records of the
+// node.
+func ListISCSINodeRecords(logger *zap.SugaredLogger) ([]Disk, error) {
+	c := &iSCSIMounter{runner: exec.New(), logger: logger}
+	return c.nodeRecords()
+}
+
+// parseISCSISessions parses the output of iscsiadm -m session -P 1.
+func parseISCSISessions(output string) []ISCSISession {
+	var (
+		sessions []ISCSISession
+		iqn      string
+		portal   string
+	)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "Target:"):
+			iqn, portal = "", ""
+			if fields := strings.Fields(strings.TrimPrefix(line, "Target:")); len(fields) > 0 {
+				iqn = fields[0]
+			}
+		case strings.HasPrefix(line, "Current Portal:"):
+			portal = strings.TrimSpace(strings.TrimPrefix(line, "Current Portal:"))
+		case strings.HasPrefix(line, "iSCSI Session State:"):
+			target, ok := parsePortal(iqn, portal)
+			if !ok {
+				continue
+			}
+			sessions = append(sessions, ISCSISession{
+				Disk:  target,
+				State: strings.TrimSpace(strings.TrimPrefix(line, "iSCSI Session State:")),
+			})
+		}
+	}
+	return sessions
+}
+
+// parseISCSINodeRecords parses the output of iscsiadm -m node, a node record
+// per line: <ip>:<port>,<target portal group tag> <iqn>
+func parseISCSINodeRecords(output string) []Disk {
+	var records []Disk
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if target, ok := parsePortal(fields[1], fields[0]); ok {
+			records = append(records, target)
+		}
+	}
+	return records
+}
+
+// parsePortal returns the target of the IQN at the portal in the format
+// ip:port,<target portal group tag> for IPv4 and [ip]:port,<target portal
+// group tag> for IPv6.
+func parsePortal(iqn, portal string) (Disk, bool) {
+	host, port, err := net.SplitHostPort(strings.Split(portal, ",")[0])
+	if err != nil || iqn == "" {
+		return Disk{}, false
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return Disk{}, false
+	}
+	return Disk{IQN: iqn, IscsiIp: host, Port: portNumber}, true
+}
+
+// ISCSIDiskDevices returns the SCSI disks of the LUNs of the iSCSI target, by
+// kernel name.
+func ISCSIDiskDevices(sd *Disk) ([]string, error) {
+	return iSCSIDiskDevices(DISK_BY_PATH_FOLDER, sd)
+}
+
+func iSCSIDiskDevices(diskByPathDir string, sd *Disk) ([]string, error) {
+	entries, err := os.ReadDir(diskByPathDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// ip-<ip>:<port>-iscsi-<iqn>-lun-<lun>
+	prefix := fmt.Sprintf("ip-%s-iscsi-%s-lun-", sd.Target(), sd.IQN)
+	var devices []string
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), prefix) || strings.Contains(entry.Name(), "-part") {
+			continue
+		}
+		device, err := filepath.EvalSymlinks(filepath.Join(diskByPathDir, entry.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		devices = append(devices, filepath.Base(device))
+	}
+	return devices, nil
+}
+
+func (c *iSCSIMounter) FormatAndMount(source string, target string, fstype string, options []string, formatOptions []string) error {
 	safeMounter := &mount.SafeFormatAndMount{
 		Interface: c.mounter,
 		Exec:      c.runner,
 	}
-	return formatAndMount(source, target, fstype, options, safeMounter)
+	return formatAndMount(source, target, fstype, options, formatOptions, safeMounter)
 }
 
-func formatAndMount(source string, target string, fstype string, options []string, sm *mount.SafeFormatAndMount) error {
-	return sm.FormatAndMount(source, target, fstype, options)
+func formatAndMount(source string, target string, fstype string, options []string, formatOptions []string, sm *mount.SafeFormatAndMount) error {
+	return sm.FormatAndMountSensitiveWithFormatOptions(source, target, fstype, options, nil, formatOptions)
 }
 
 func (c *iSCSIMounter) GetDiskFormat(disk string) (string, error) {
@@ -15,6 +15,8 @@
 package disk
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -70,3 +72,85 @@ func TestGetMountPointForPath(t *testing.T) {
 		})
 	}
 }
+
+func TestParseISCSISessions(t *testing.T) {
+	output := `Target: iqn.2015-02.oracle.boot:uefi (non-flash)
+	Current Portal: 169.254.0.2:3260,1
+	Persistent Portal: 169.254.0.2:3260,1
+		**********
+		Interface:
+		**********
+		Iface Name: default
+		iSCSI Connection State: LOGGED IN
+		iSCSI Session State: LOGGED_IN
+		Internal iscsid Session State: NO CHANGE
+Target: iqn.2015-12.com.oracleiaas:volume (non-flash)
+	Current Portal: 169.254.2.2:3260,1
+	Persistent Portal: 169.254.2.2:3260,1
+		**********
+		Interface:
+		**********
+		Iface Name: default
+		iSCSI Connection State: TRANSPORT WAIT
+		iSCSI Session State: FAILED
+		Internal iscsid Session State: REOPEN
+Target: iqn.2015-12.com.oracleiaas:ipv6 (non-flash)
+	Current Portal: [fd00:c1::a9fe:202]:3260,1
+	Persistent Portal: [fd00:c1::a9fe:202]:3260,1
+		iSCSI Session State: LOGGED_IN
+`
+	expected := []ISCSISession{
+		{Disk: Disk{IQN: "iqn.2015-02.oracle.boot:uefi", IscsiIp: "169.254.0.2", Port: 3260}, State: "LOGGED_IN"},
+		{Disk: Disk{IQN: "iqn.2015-12.com.oracleiaas:volume", IscsiIp: "169.254.2.2", Port: 3260}, State: "FAILED"},
+		{Disk: Disk{IQN: "iqn.2015-12.com.oracleiaas:ipv6", IscsiIp: "fd00:c1::a9fe:202", Port: 3260}, State: "LOGGED_IN"},
+	}
+	if result := parseISCSISessions(output); !reflect.DeepEqual(result, expected) {
+		t.Errorf("parseISCSISessions() =>\n%+v\nExpected: %+v", result, expected)
+	}
+}
+
+func TestParseISCSINodeRecords(t *testing.T) {
+	output := `169.254.0.2:3260,1 iqn.2015-02.oracle.boot:uefi
+169.254.2.2:3260,1 iqn.2015-12.com.oracleiaas:volume
+[fd00:c1::a9fe:202]:3260,1 iqn.2015-12.com.oracleiaas:ipv6
+iscsiadm: invalid line
+`
+	expected := []Disk{
+		{IQN: "iqn.2015-02.oracle.boot:uefi", IscsiIp: "169.254.0.2", Port: 3260},
+		{IQN: "iqn.2015-12.com.oracleiaas:volume", IscsiIp: "169.254.2.2", Port: 3260},
+		{IQN: "iqn.2015-12.com.oracleiaas:ipv6", IscsiIp: "fd00:c1::a9fe:202", Port: 3260},
+	}
+	if result := parseISCSINodeRecords(output); !reflect.DeepEqual(result, expected) {
+		t.Errorf("parseISCSINodeRecords() =>\n%+v\nExpected: %+v", result, expected)
+	}
+}
+
+func TestISCSIDiskDevices(t *testing.T) {
+	root := t.TempDir()
+	byPath := filepath.Join(root, "by-path")
+	if err := os.MkdirAll(byPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	links := map[string]string{
+		"ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:volume-lun-1":       "sdb",
+		"ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:volume-lun-1-part1": "sdb1",
+		"ip-169.254.2.2:3260-iscsi-iqn.2015-12.com.oracleiaas:other-lun-1":        "sdc",
+		"ip-169.254.2.3:3260-iscsi-iqn.2015-12.com.oracleiaas:volume-lun-1":       "sdd",
+	}
+	for link, device := range links {
+		if err := os.WriteFile(filepath.Join(root, device), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join("..", device), filepath.Join(byPath, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := iSCSIDiskDevices(byPath, &Disk{IQN: "iqn.2015-12.com.oracleiaas:volume", IscsiIp: "169.254.2.2", Port: 3260})
+	if err != nil {
+		t.Fatalf("iSCSIDiskDevices() => error: %v", err)
+	}
+	if expected := []string{"sdb"}; !reflect.DeepEqual(result, expected) {
+		t.Errorf("iSCSIDiskDevices() =>\n%+v\nExpected: %+v", result, expected)
+	}
+}
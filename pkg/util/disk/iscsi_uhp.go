@@ -23,6 +23,11 @@ const (
 	pathPollIntervalUHP  = 10 * time.Second
 
 	CHROOT_BASH_COMMAND = "chroot-bash"
+
+	// SCSIDeviceStateRunning is the state of SCSI devices that accept I/O
+	SCSIDeviceStateRunning = "running"
+
+	sysBlockPath = "/sys/block"
 )
 
 // iSCSIUHPMounter implements Interface.
@@ -47,12 +52,12 @@ func (c *iSCSIUHPMounter) AddToDB() error {
 	return nil
 }
 
-func (c *iSCSIUHPMounter) FormatAndMount(source string, target string, fstype string, options []string) error {
+func (c *iSCSIUHPMounter) FormatAndMount(source string, target string, fstype string, options []string, formatOptions []string) error {
 	safeMounter := &mount.SafeFormatAndMount{
 		Interface: c.mounter,
 		Exec:      c.runner,
 	}
-	return formatAndMount(source, target, fstype, options, safeMounter)
+	return formatAndMount(source, target, fstype, options, formatOptions, safeMounter)
 }
 
 func (c *iSCSIUHPMounter) Mount(source string, target string, fstype string, options []string) error {
@@ -166,6 +171,64 @@ func (c *iSCSIUHPMounter) GetISCSILoginState(multipathDevices []core.MultipathDe
 	return true, nil
 }
 
+// SessionLoggedIn returns whether an iSCSI session of the paths of the
+// multipath device is logged in, that is the SCSI device of one of its paths
+// is running. The SCSI devices of the paths whose sessions are lost are
+// blocked or offline.
+func (c *iSCSIUHPMounter) SessionLoggedIn(devicePath string) (bool, error) {
+	pathStates, err := MultipathPathStates(devicePath)
+	if err != nil {
+		return false, err
+	}
+	for _, state := range pathStates {
+		if state == SCSIDeviceStateRunning {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MultipathPathStates returns the states of the SCSI devices of the paths of
+// the multipath device, by name of the SCSI device.
+func MultipathPathStates(devicePath string) (map[string]string, error) {
+	return multipathPathStates(sysBlockPath, devicePath)
+}
+
+func multipathPathStates(sysBlockPath, devicePath string) (map[string]string, error) {
+	// /dev/mapper/<friendly name> links to the device mapper device /dev/dm-<n>
+	dmDevicePath, err := filepath.EvalSymlinks(devicePath)
+	if err != nil {
+		return nil, err
+	}
+	slaves, err := os.ReadDir(filepath.Join(sysBlockPath, filepath.Base(dmDevicePath), "slaves"))
+	if err != nil {
+		return nil, err
+	}
+
+	pathStates := make(map[string]string, len(slaves))
+	for _, slave := range slaves {
+		state, err := os.ReadFile(filepath.Join(sysBlockPath, slave.Name(), "device", "state"))
+		if err != nil {
+			return nil, err
+		}
+		pathStates[slave.Name()] = strings.TrimSpace(string(state))
+	}
+	return pathStates, nil
+}
+
+// FlushMultipathDevice removes the multipath device map of the device mapper
+// device, which must not be in use.
+func FlushMultipathDevice(logger *zap.SugaredLogger, deviceMapperName string) error {
+	logger.With("deviceMapperName", deviceMapperName).Info("Flushing multipath device")
+	args := []string{"multipath -f", deviceMapperName}
+	command := cmdexec.Command(CHROOT_BASH_COMMAND, args...)
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command failed: %v\narguments: %s\nOutput: %v\n", err, CHROOT_BASH_COMMAND, string(output))
+	}
+	return nil
+}
+
 func (c *iSCSIUHPMounter) WaitForVolumeLoginOrTimeout(ctx context.Context, multipathDevices []core.MultipathDevice) error {
 	ctx, cancel := context.WithTimeout(ctx, volumeLoginTimeout)
 	defer cancel()
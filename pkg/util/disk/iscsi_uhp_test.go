@@ -0,0 +1,61 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMultipathPathStates(t *testing.T) {
+	root := t.TempDir()
+	sysBlock := filepath.Join(root, "sys", "block")
+	dev := filepath.Join(root, "dev")
+	for _, dir := range []string{filepath.Join(sysBlock, "dm-0", "slaves", "sdb"), filepath.Join(sysBlock, "dm-0", "slaves", "sdc"), filepath.Join(dev, "mapper")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for disk, state := range map[string]string{"sdb": "running", "sdc": "transport-offline"} {
+		device := filepath.Join(sysBlock, disk, "device")
+		if err := os.MkdirAll(device, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(device, "state"), []byte(state+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dev, "dm-0"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("../dm-0", filepath.Join(dev, "mapper", "mpatha")); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := multipathPathStates(sysBlock, filepath.Join(dev, "mapper", "mpatha"))
+	if err != nil {
+		t.Fatalf("multipathPathStates() => error: %v", err)
+	}
+	expected := map[string]string{"sdb": "running", "sdc": "transport-offline"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("multipathPathStates() =>\n%+v\nExpected: %+v", result, expected)
+	}
+
+	if _, err := multipathPathStates(sysBlock, filepath.Join(dev, "mapper", "mpathb")); err == nil {
+		t.Errorf("multipathPathStates() of a missing device => no error")
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	cmdexec "os/exec"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// CryptsetupCommand runs cryptsetup on the host
+	CryptsetupCommand = "cryptsetup-host"
+
+	// LUKSMapperPrefix is the prefix of the device mapper names of the LUKS
+	// devices of volumes
+	LUKSMapperPrefix = "oci-luks-"
+	// LUKSFormatType is the disk format of LUKS devices reported by blkid
+	LUKSFormatType = "crypto_LUKS"
+
+	deviceMapperPath = "/dev/mapper/"
+	// cryptsetupNotLUKSExitStatus is the exit status of cryptsetup isLuks
+	// for devices which are not LUKS devices
+	cryptsetupNotLUKSExitStatus = 1
+)
+
+// LUKSMapperName returns the device mapper name of the LUKS device of the
+// volume, from the unique part of its OCID.
+func LUKSMapperName(volumeID string) string {
+	return LUKSMapperPrefix + volumeID[strings.LastIndex(volumeID, ".")+1:]
+}
+
+// LUKSMapperPath returns the path of the LUKS device with the device mapper
+// name.
+func LUKSMapperPath(mapperName string) string {
+	return deviceMapperPath + mapperName
+}
+
+// LUKSOpened returns whether the LUKS device with the device mapper name is
+// open.
+func LUKSOpened(mapperName string) (bool, error) {
+	if _, err := os.Stat(LUKSMapperPath(mapperName)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// LUKSDeviceOpened returns whether the LUKS device with the device mapper name
+// is open and in use, e.g. mounted.
+func LUKSDeviceOpened(logger *zap.SugaredLogger, mapperName string) (bool, error) {
+	return deviceOpened(LUKSMapperPath(mapperName), logger)
+}
+
+// IsLUKS returns whether the device is formatted as a LUKS device.
+func IsLUKS(devicePath string) (bool, error) {
+	if _, err := cryptsetup("", "isLuks", devicePath); err != nil {
+		var exitErr *cmdexec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == cryptsetupNotLUKSExitStatus {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// LUKSFormat formats the device as a LUKS2 device encrypted with the key.
+func LUKSFormat(logger *zap.SugaredLogger, devicePath, key string) error {
+	logger.With("devicePath", devicePath).Info("Formatting the device with LUKS.")
+	if _, err := cryptsetup(key, "luksFormat", "--type", "luks2", "--batch-mode", "--key-file", "-", devicePath); err != nil {
+		return err
+	}
+	logger.With("devicePath", devicePath).Info("Formatted the device with LUKS.")
+	return nil
+}
+
+// LUKSOpen opens the LUKS device with the key as the device mapper name, if it
+// is not open. The volume key is kept out of the kernel keyring so that the
+// LUKS device can be resized without the key.
+func LUKSOpen(logger *zap.SugaredLogger, devicePath, mapperName, key string) error {
+	opened, err := LUKSOpened(mapperName)
+	if err != nil {
+		return err
+	}
+	if opened {
+		logger.With("devicePath", devicePath, "mapperName", mapperName).Info("LUKS device is already open.")
+		return nil
+	}
+	logger.With("devicePath", devicePath, "mapperName", mapperName).Info("Opening the LUKS device.")
+	if _, err := cryptsetup(key, "luksOpen", "--disable-keyring", "--key-file", "-", devicePath, mapperName); err != nil {
+		return err
+	}
+	logger.With("devicePath", devicePath, "mapperName", mapperName).Info("Opened the LUKS device.")
+	return nil
+}
+
+// LUKSClose closes the LUKS device with the device mapper name, if it is open.
+func LUKSClose(logger *zap.SugaredLogger, mapperName string) error {
+	opened, err := LUKSOpened(mapperName)
+	if err != nil || !opened {
+		return err
+	}
+	logger.With("mapperName", mapperName).Info("Closing the LUKS device.")
+	if _, err := cryptsetup("", "luksClose", mapperName); err != nil {
+		return err
+	}
+	logger.With("mapperName", mapperName).Info("Closed the LUKS device.")
+	return nil
+}
+
+// LUKSResize resizes the open LUKS device with the device mapper name to the
+// size of its backing device.
+func LUKSResize(logger *zap.SugaredLogger, mapperName string) error {
+	logger.With("mapperName", mapperName).Info("Resizing the LUKS device.")
+	if _, err := cryptsetup("", "resize", mapperName); err != nil {
+		return err
+	}
+	logger.With("mapperName", mapperName).Info("Resized the LUKS device.")
+	return nil
+}
+
+// LUKSMapperNameForDevice returns the device mapper name of the device if it
+// is the LUKS device of a volume, given as /dev/mapper/<name> or /dev/dm-<N>.
+func LUKSMapperNameForDevice(devicePath string) (string, bool) {
+	return luksMapperNameForDevice(sysBlockPath, devicePath)
+}
+
+func luksMapperNameForDevice(sysBlockPath, devicePath string) (string, bool) {
+	name := strings.TrimPrefix(devicePath, deviceMapperPath)
+	if strings.HasPrefix(devicePath, "/dev/dm-") {
+		var err error
+		if name, err = deviceMapperName(sysBlockPath, devicePath); err != nil {
+			return "", false
+		}
+	} else if name == devicePath {
+		return "", false
+	}
+	return name, strings.HasPrefix(name, LUKSMapperPrefix)
+}
+
+// LUKSBackingDevice returns the path of the device backing the open LUKS
+// device with the device mapper name.
+func LUKSBackingDevice(mapperName string) (string, error) {
+	output, err := cryptsetup("", "status", mapperName)
+	if err != nil {
+		return "", err
+	}
+	devicePath, err := parseLUKSBackingDevice(output)
+	if err != nil {
+		return "", err
+	}
+	// multipath devices are referred to by their device mapper path
+	if strings.HasPrefix(devicePath, "/dev/dm-") {
+		name, err := deviceMapperName(sysBlockPath, devicePath)
+		if err != nil {
+			return "", err
+		}
+		devicePath = deviceMapperPath + name
+	}
+	return devicePath, nil
+}
+
+// deviceMapperName returns the device mapper name of the /dev/dm-<N> device.
+func deviceMapperName(sysBlockPath, devicePath string) (string, error) {
+	name, err := os.ReadFile(filepath.Join(sysBlockPath, filepath.Base(devicePath), "dm", "name"))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(name)), nil
+}
+
+// parseLUKSBackingDevice parses the device of the output of cryptsetup status:
+//
+//	/dev/mapper/oci-luks-abc is active.
+//	  type:    LUKS2
+//	  device:  /dev/sdb
+func parseLUKSBackingDevice(output string) (string, error) {
+	for _, line := range strings.Split(output, "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "device:" {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("device not found in cryptsetup status output: %q", output)
+}
+
+// cryptsetup runs cryptsetup with the arguments, passing the key, if any, on
+// its standard input.
+func cryptsetup(key string, args ...string) (string, error) {
+	cmd := cmdexec.Command(CryptsetupCommand, args...)
+	if key != "" {
+		cmd.Stdin = strings.NewReader(key)
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("cryptsetup %s failed: %w, output: %s", args[0], err, string(output))
+	}
+	return string(output), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLUKSMapperName(t *testing.T) {
+	got := LUKSMapperName("ocid1.volume.oc1.iad.abuwcljrexample")
+	if want := "oci-luks-abuwcljrexample"; got != want {
+		t.Errorf("LUKSMapperName() = %q, want %q", got, want)
+	}
+}
+
+func TestLUKSMapperNameForDevice(t *testing.T) {
+	sysBlock := t.TempDir()
+	for device, name := range map[string]string{"dm-0": "mpatha", "dm-1": "oci-luks-abuwcljrexample"} {
+		if err := os.MkdirAll(filepath.Join(sysBlock, device, "dm"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(sysBlock, device, "dm", "name"), []byte(name+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		devicePath string
+		wantName   string
+		wantOK     bool
+	}{
+		{devicePath: "/dev/mapper/oci-luks-abuwcljrexample", wantName: "oci-luks-abuwcljrexample", wantOK: true},
+		{devicePath: "/dev/mapper/mpatha", wantName: "mpatha"},
+		{devicePath: "/dev/dm-1", wantName: "oci-luks-abuwcljrexample", wantOK: true},
+		{devicePath: "/dev/dm-0", wantName: "mpatha"},
+		{devicePath: "/dev/dm-2"},
+		{devicePath: "/dev/sdb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.devicePath, func(t *testing.T) {
+			name, ok := luksMapperNameForDevice(sysBlock, tt.devicePath)
+			if name != tt.wantName || ok != tt.wantOK {
+				t.Errorf("luksMapperNameForDevice() = %q, %t, want %q, %t", name, ok, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseLUKSBackingDevice(t *testing.T) {
+	output := `/dev/mapper/oci-luks-abuwcljrexample is active and is in use.
+  type:    LUKS2
+  cipher:  aes-xts-plain64
+  keysize: 512 bits
+  key location: dm-crypt
+  device:  /dev/sdb
+  sector size:  512
+  offset:  32768 sectors
+  size:    104824832 sectors
+  mode:    read/write
+`
+	got, err := parseLUKSBackingDevice(output)
+	if err != nil {
+		t.Fatalf("parseLUKSBackingDevice() error = %v", err)
+	}
+	if got != "/dev/sdb" {
+		t.Errorf("parseLUKSBackingDevice() = %q, want %q", got, "/dev/sdb")
+	}
+
+	if _, err := parseLUKSBackingDevice("/dev/mapper/oci-luks-abuwcljrexample is inactive.\n"); err == nil {
+		t.Error("parseLUKSBackingDevice() of an inactive device, want error")
+	}
+}
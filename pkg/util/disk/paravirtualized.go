@@ -49,6 +49,10 @@ func (c *pvMounter) WaitForVolumeLoginOrTimeout(ctx context.Context, multipathDe
 	return nil
 }
 
+func (c *pvMounter) SessionLoggedIn(devicePath string) (bool, error) {
+	return true, nil
+}
+
 func (c *pvMounter) AddToDB() error {
 	c.logger.Info("Attachment type paravirtualized. AddToDB() not needed for paravirtualized attachment")
 	return nil
@@ -79,12 +83,12 @@ func (c *pvMounter) RemoveFromDB() error {
 	return nil
 }
 
-func (c *pvMounter) FormatAndMount(source string, target string, fstype string, options []string) error {
+func (c *pvMounter) FormatAndMount(source string, target string, fstype string, options []string, formatOptions []string) error {
 	safeMounter := &mount.SafeFormatAndMount{
 		Interface: c.mounter,
 		Exec:      c.runner,
 	}
-	return formatAndMount(source, target, fstype, options, safeMounter)
+	return formatAndMount(source, target, fstype, options, formatOptions, safeMounter)
 }
 
 func (c *pvMounter) Mount(source string, target string, fstype string, options []string) error {
@@ -886,11 +886,12 @@ var (
 			},
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: map[string]string{
-					LabelTopologyZone:          "PHX-AD-3",
-					LabelFailureDomainBetaZone: "PHX-AD-3",
-					LabelIpFamilyPreferred:     "IPv4",
-					LabelIpFamilyIpv4:          "true",
-					LabelIpFamilyIpv6:          "true",
+					LabelTopologyZone:           "PHX-AD-3",
+					LabelFailureDomainBetaZone:  "PHX-AD-3",
+					LabelIpFamilyPreferred:      "IPv4",
+					LabelIpFamilyIpv4:           "true",
+					LabelIpFamilyIpv6:           "true",
+					api.LabelInstanceTypeStable: "VM.Standard.E4.Flex",
 				},
 			},
 		},
@@ -82,6 +82,14 @@ func (c *MockBlockStorageClient) GetVolumesByName(ctx context.Context, volumeNam
 	return nil, nil
 }
 
+func (c *MockBlockStorageClient) ListVolumes(ctx context.Context, compartmentID string, limit int, page string) ([]core.Volume, string, error) {
+	return nil, "", nil
+}
+
+func (c *MockBlockStorageClient) ListVolumeBackups(ctx context.Context, compartmentID, volumeID string, limit int, page string) ([]core.VolumeBackup, string, error) {
+	return nil, "", nil
+}
+
 // CreateVolume mocks the BlockStorage CreateVolume implementation
 func (c *MockBlockStorageClient) CreateVolume(ctx context.Context, details core.CreateVolumeDetails) (*core.Volume, error) {
 	return &core.Volume{Id: &VolumeBackupID}, nil
@@ -91,6 +99,78 @@ func (c *MockBlockStorageClient) UpdateVolume(ctx context.Context, volumeId stri
 	return &core.Volume{Id: &volumeId}, nil
 }
 
+func (c *MockBlockStorageClient) UpdateVolumeKmsKey(ctx context.Context, volumeId, kmsKeyID string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) CopyVolumeBackup(ctx context.Context, volumeBackupID, destinationRegion, displayName string) (*core.VolumeBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupCopies(ctx context.Context, sourceVolumeBackupID, compartmentID string) ([]core.VolumeBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) ForRegion(region string) (client.BlockStorageInterface, error) {
+	return c, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyByName(ctx context.Context, policyName, compartmentID string) (*core.VolumeBackupPolicy, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AssignVolumeBackupPolicy(ctx context.Context, volumeID, policyID string) (*core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeBackupPolicyAssignments(ctx context.Context, volumeID string) ([]core.VolumeBackupPolicyAssignment, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetBlockVolumeReplica(ctx context.Context, id string) (*core.BlockVolumeReplica, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) AwaitVolumeGroupAvailableOrTimeout(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroup(ctx context.Context, details core.CreateVolumeGroupDetails) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroup(ctx context.Context, id string) (*core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupsByName(ctx context.Context, volumeGroupName, compartmentID string) ([]core.VolumeGroup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) CreateVolumeGroupBackup(ctx context.Context, details core.CreateVolumeGroupBackupDetails) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) DeleteVolumeGroupBackup(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackup(ctx context.Context, id string) (*core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
+func (c *MockBlockStorageClient) GetVolumeGroupBackupsByName(ctx context.Context, volumeGroupBackupName, compartmentID string) ([]core.VolumeGroupBackup, error) {
+	return nil, nil
+}
+
 // DeleteVolume mocks the BlockStorage DeleteVolume implementation
 func (c *MockBlockStorageClient) DeleteVolume(ctx context.Context, id string) error {
 	return nil
@@ -233,6 +313,10 @@ func (c *MockFileStorageClient) AwaitMountTargetActive(ctx context.Context, logg
 
 type MockComputeClient struct{}
 
+func (c *MockComputeClient) ListVnicAttachmentsByCompartmentAndAD(ctx context.Context, compartmentID, availabilityDomain string) ([]core.VnicAttachment, error) {
+	return nil, nil
+}
+
 func (c *MockComputeClient) ListInstancesByCompartmentAndAD(ctx context.Context, compartmentId, availabilityDomain string) (response []core.Instance, err error) {
 	return nil, nil
 }
@@ -272,11 +356,11 @@ func (c *MockComputeClient) FindVolumeAttachment(ctx context.Context, compartmen
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachParavirtualizedVolume(ctx context.Context, instanceID, volumeID string, isPvEncryptionInTransitEnabled bool, isShareable bool, isReadOnly bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
-func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable bool) (core.VolumeAttachment, error) {
+func (c *MockComputeClient) AttachVolume(ctx context.Context, instanceID, volumeID string, isShareable, isReadOnly, useChap bool) (core.VolumeAttachment, error) {
 	return nil, nil
 }
 
@@ -414,6 +498,10 @@ func (p *MockProvisionerClient) Identity(ociClientConfig *client.OCIClientConfig
 	return &MockIdentityClient{}
 }
 
+func (p *MockProvisionerClient) Limits() client.LimitsInterface {
+	return nil
+}
+
 // FSS mocks client FileStorage implementation
 func (p *MockProvisionerClient) FSS(ociClientConfig *client.OCIClientConfig) client.FileStorageInterface {
 	return &MockFileStorageClient{}